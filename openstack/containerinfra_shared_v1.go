@@ -1,15 +1,20 @@
 package openstack
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/pem"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,6 +31,7 @@ const (
 	rsaPrivateKeyBlockType      = "RSA PRIVATE KEY"
 	certificateRequestBlockType = "CERTIFICATE REQUEST"
 
+	containerInfraV1ClusterResizeMinMicroversion  = "1.7"
 	containerInfraV1ClusterUpgradeMinMicroversion = "1.8"
 	containerInfraV1NodeGroupMinMicroversion      = "1.9"
 	containerInfraV1ZeroNodeCountMicroversion     = "1.10"
@@ -173,6 +179,39 @@ func containerInfraNodeGroupV1StateRefreshFunc(client *gophercloud.ServiceClient
 	}
 }
 
+// containerInfraV1WaitForUpdate waits for an update, resize or upgrade of a
+// container infra cluster or node group to complete. An update, which was
+// rolled back by Heat, is returned as an error.
+func containerInfraV1WaitForUpdate(ctx context.Context, refresh resource.StateRefreshFunc, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"UPDATE_IN_PROGRESS", "ROLLBACK_IN_PROGRESS"},
+		Target:       []string{"UPDATE_COMPLETE", "ROLLBACK_COMPLETE"},
+		Refresh:      refresh,
+		Timeout:      timeout,
+		Delay:        1 * time.Minute,
+		PollInterval: 20 * time.Second,
+	}
+
+	v, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var status, statusReason string
+	switch r := v.(type) {
+	case *clusters.Cluster:
+		status, statusReason = r.Status, r.StatusReason
+	case *nodegroups.NodeGroup:
+		status, statusReason = r.Status, r.StatusReason
+	}
+
+	if status == "ROLLBACK_COMPLETE" {
+		return fmt.Errorf("the update was rolled back: %s", statusReason)
+	}
+
+	return nil
+}
+
 // containerInfraClusterV1Flavor will determine the flavor for a container infra
 // cluster based on either what was set in the configuration or environment
 // variable.
@@ -237,8 +276,23 @@ type kubernetesConfigUser struct {
 }
 
 type kubernetesConfigUserData struct {
-	ClientKeyData         string `yaml:"client-key-data"`
-	ClientCertificateData string `yaml:"client-certificate-data"`
+	ClientKeyData         string                    `yaml:"client-key-data,omitempty"`
+	ClientCertificateData string                    `yaml:"client-certificate-data,omitempty"`
+	Token                 string                    `yaml:"token,omitempty"`
+	Exec                  *kubernetesConfigUserExec `yaml:"exec,omitempty"`
+}
+
+type kubernetesConfigUserExec struct {
+	APIVersion      string                        `yaml:"apiVersion"`
+	Command         string                        `yaml:"command"`
+	Args            []string                      `yaml:"args,omitempty"`
+	Env             []kubernetesConfigUserExecEnv `yaml:"env,omitempty"`
+	InteractiveMode string                        `yaml:"interactiveMode,omitempty"`
+}
+
+type kubernetesConfigUserExecEnv struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
 }
 
 func flattenContainerInfraV1Kubeconfig(d *schema.ResourceData, containerInfraClient *gophercloud.ServiceClient) (map[string]interface{}, error) {
@@ -252,24 +306,48 @@ func flattenContainerInfraV1Kubeconfig(d *schema.ResourceData, containerInfraCli
 		return nil, fmt.Errorf("Error getting certificate authority: %s", err)
 	}
 
+	clientCertificate, pemClientKey, err := containerInfraV1CreateClientCertificate(containerInfraClient, d.Id(), "admin", "system:masters")
+	if err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	host := d.Get("api_address").(string)
+	rawKubeconfig, err := renderKubeconfig(name, host, []byte(certificateAuthority.PEM), []byte(clientCertificate), pemClientKey)
+	if err != nil {
+		return nil, fmt.Errorf("Error rendering kubeconfig: %s", err)
+	}
+
+	return map[string]interface{}{
+		"raw_config":             string(rawKubeconfig),
+		"host":                   host,
+		"cluster_ca_certificate": certificateAuthority.PEM,
+		"client_certificate":     clientCertificate,
+		"client_key":             string(pemClientKey),
+	}, nil
+}
+
+// containerInfraV1CreateClientCertificate generates a client key and lets
+// the certificate authority of a cluster sign a client certificate for it.
+func containerInfraV1CreateClientCertificate(containerInfraClient *gophercloud.ServiceClient, clusterID, commonName, organization string) (string, []byte, error) {
 	clientKey, err := rsa.GenerateKey(rand.Reader, 4096)
 	if err != nil {
-		return nil, fmt.Errorf("Error generating client key: %s", err)
+		return "", nil, fmt.Errorf("Error generating client key: %s", err)
 	}
 
 	csrTemplate := x509.CertificateRequest{
 		PublicKey:          clientKey.Public,
 		SignatureAlgorithm: x509.SHA512WithRSA,
 		Subject: pkix.Name{
-			CommonName:         "admin",
-			Organization:       []string{"system:masters"},
+			CommonName:         commonName,
+			Organization:       []string{organization},
 			OrganizationalUnit: []string{"terraform"},
 		},
 	}
 
 	clientCsr, err := x509.CreateCertificateRequest(rand.Reader, &csrTemplate, clientKey)
 	if err != nil {
-		return nil, fmt.Errorf("Error generating client CSR: %s", err)
+		return "", nil, fmt.Errorf("Error generating client CSR: %s", err)
 	}
 
 	pemClientKey := pem.EncodeToMemory(
@@ -287,34 +365,30 @@ func flattenContainerInfraV1Kubeconfig(d *schema.ResourceData, containerInfraCli
 	)
 
 	certificateCreateOpts := certificates.CreateOpts{
-		ClusterUUID: d.Id(),
+		ClusterUUID: clusterID,
 		CSR:         string(pemClientCsr),
 	}
 
 	clientCertificate, err := certificates.Create(containerInfraClient, certificateCreateOpts).Extract()
 	if err != nil {
-		return nil, fmt.Errorf("Error requesting client certificate: %s", err)
+		return "", nil, fmt.Errorf("Error requesting client certificate: %s", err)
 	}
 
-	name := d.Get("name").(string)
-	host := d.Get("api_address").(string)
-	rawKubeconfig, err := renderKubeconfig(name, host, []byte(certificateAuthority.PEM), []byte(clientCertificate.PEM), pemClientKey)
-	if err != nil {
-		return nil, fmt.Errorf("Error rendering kubeconfig: %s", err)
-	}
-
-	return map[string]interface{}{
-		"raw_config":             string(rawKubeconfig),
-		"host":                   host,
-		"cluster_ca_certificate": certificateAuthority.PEM,
-		"client_certificate":     clientCertificate.PEM,
-		"client_key":             string(pemClientKey),
-	}, nil
+	return clientCertificate.PEM, pemClientKey, nil
 }
 
 func renderKubeconfig(name string, host string, clusterCaCertificate []byte, clientCertificate []byte, clientKey []byte) ([]byte, error) {
-	userName := fmt.Sprintf("%s-admin", name)
+	user := kubernetesConfigUserData{
+		ClientCertificateData: base64.StdEncoding.EncodeToString(clientCertificate),
+		ClientKeyData:         base64.StdEncoding.EncodeToString(clientKey),
+	}
+
+	return renderKubeconfigUser(name, host, clusterCaCertificate, fmt.Sprintf("%s-admin", name), user)
+}
 
+// renderKubeconfigUser renders a kubeconfig with a single cluster, context
+// and user.
+func renderKubeconfigUser(name string, host string, clusterCaCertificate []byte, userName string, user kubernetesConfigUserData) ([]byte, error) {
 	config := kubernetesConfig{
 		APIVersion: "v1",
 		Kind:       "Config",
@@ -340,13 +414,68 @@ func renderKubeconfig(name string, host string, clusterCaCertificate []byte, cli
 		Users: []kubernetesConfigUser{
 			{
 				Name: userName,
-				User: kubernetesConfigUserData{
-					ClientCertificateData: base64.StdEncoding.EncodeToString(clientCertificate),
-					ClientKeyData:         base64.StdEncoding.EncodeToString(clientKey),
-				},
+				User: user,
 			},
 		},
 	}
 
 	return yaml.Marshal(config)
 }
+
+// expandContainerInfraV1KubeconfigExec builds the exec credential plugin
+// configuration of a kubeconfig user.
+func expandContainerInfraV1KubeconfigExec(command, keystoneURL string, rawArgs []interface{}, rawEnv map[string]interface{}) *kubernetesConfigUserExec {
+	args := []string{}
+	if keystoneURL != "" {
+		args = append(args, fmt.Sprintf("--keystone-url=%s", keystoneURL))
+	}
+	args = append(args, expandToStringSlice(rawArgs)...)
+
+	names := make([]string, 0, len(rawEnv))
+	for name := range rawEnv {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	env := make([]kubernetesConfigUserExecEnv, len(names))
+	for i, name := range names {
+		env[i] = kubernetesConfigUserExecEnv{
+			Name:  name,
+			Value: rawEnv[name].(string),
+		}
+	}
+
+	return &kubernetesConfigUserExec{
+		APIVersion:      "client.authentication.k8s.io/v1beta1",
+		Command:         command,
+		Args:            args,
+		Env:             env,
+		InteractiveMode: "Never",
+	}
+}
+
+// containerInfraV1CertificateFingerprint returns the SHA256 fingerprint of a
+// PEM encoded certificate.
+func containerInfraV1CertificateFingerprint(pemCertificate string) (string, error) {
+	block, _ := pem.Decode([]byte(pemCertificate))
+	if block == nil {
+		return "", fmt.Errorf("no PEM data found")
+	}
+
+	sum := sha256.Sum256(block.Bytes)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// containerInfraV1NodesToRemove returns the nodes_to_remove, which are sent
+// with a node_count change. They are only sent, when the node count is
+// decreased and the list itself changed, because the listed nodes are already
+// gone after the resize, which removed them.
+func containerInfraV1NodesToRemove(d *schema.ResourceData) []string {
+	oldCount, newCount := d.GetChange("node_count")
+	if newCount.(int) >= oldCount.(int) || !d.HasChange("nodes_to_remove") {
+		return nil
+	}
+
+	return expandToStringSlice(d.Get("nodes_to_remove").([]interface{}))
+}
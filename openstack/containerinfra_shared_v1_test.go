@@ -1,8 +1,11 @@
 package openstack
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clustertemplates"
@@ -66,3 +69,97 @@ func TestUnitContainerInfraClusterTemplateV1AppendUpdateOpts(t *testing.T) {
 
 	assert.Equal(t, expectedUpdateOpts, actualUpdateOpts)
 }
+
+func TestUnitExpandContainerInfraV1KubeconfigExec(t *testing.T) {
+	rawArgs := []interface{}{"--domain-name=Default"}
+	rawEnv := map[string]interface{}{
+		"OS_USERNAME":     "ci",
+		"OS_PROJECT_NAME": "ci",
+	}
+
+	expected := &kubernetesConfigUserExec{
+		APIVersion: "client.authentication.k8s.io/v1beta1",
+		Command:    "client-keystone-auth",
+		Args: []string{
+			"--keystone-url=https://keystone.example.com/v3/",
+			"--domain-name=Default",
+		},
+		Env: []kubernetesConfigUserExecEnv{
+			{Name: "OS_PROJECT_NAME", Value: "ci"},
+			{Name: "OS_USERNAME", Value: "ci"},
+		},
+		InteractiveMode: "Never",
+	}
+
+	actual := expandContainerInfraV1KubeconfigExec("client-keystone-auth", "https://keystone.example.com/v3/", rawArgs, rawEnv)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitRenderKubeconfigUser(t *testing.T) {
+	user := kubernetesConfigUserData{
+		Token: "secret",
+	}
+
+	expected := `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    certificate-authority-data: Y2E=
+    server: https://10.0.0.1:6443
+  name: k8s
+contexts:
+- context:
+    cluster: k8s
+    user: k8s-keystone
+  name: k8s
+current-context: k8s
+users:
+- name: k8s-keystone
+  user:
+    token: secret
+`
+
+	actual, err := renderKubeconfigUser("k8s", "https://10.0.0.1:6443", []byte("ca"), "k8s-keystone", user)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(actual))
+}
+
+func TestUnitContainerInfraV1NodesToRemove(t *testing.T) {
+	s := schema.InternalMap(resourceContainerInfraNodeGroupV1().Schema)
+	state := &terraform.InstanceState{
+		ID: "cluster/nodegroup",
+		Attributes: map[string]string{
+			"name":              "nodegroup",
+			"cluster_id":        "cluster",
+			"node_count":        "3",
+			"nodes_to_remove.#": "1",
+			"nodes_to_remove.0": "node-0",
+		},
+	}
+
+	testCases := []struct {
+		nodeCount     int
+		nodesToRemove []interface{}
+		expected      []string
+	}{
+		{2, []interface{}{"node-1"}, []string{"node-1"}},
+		{2, []interface{}{"node-0"}, nil},
+		{4, []interface{}{"node-1"}, nil},
+	}
+
+	for _, tc := range testCases {
+		raw := map[string]interface{}{
+			"name":            "nodegroup",
+			"cluster_id":      "cluster",
+			"node_count":      tc.nodeCount,
+			"nodes_to_remove": tc.nodesToRemove,
+		}
+
+		diff, err := s.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+		assert.NoError(t, err)
+		d, err := s.Data(state, diff)
+		assert.NoError(t, err)
+
+		assert.Equal(t, tc.expected, containerInfraV1NodesToRemove(d))
+	}
+}
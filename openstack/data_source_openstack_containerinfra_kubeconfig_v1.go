@@ -0,0 +1,212 @@
+package openstack
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/certificates"
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clusters"
+)
+
+const (
+	containerInfraV1KubeconfigClientCertificate = "client_certificate"
+	containerInfraV1KubeconfigKeystoneToken     = "keystone_token"
+	containerInfraV1KubeconfigExec              = "exec"
+)
+
+func dataSourceContainerInfraKubeconfigV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceContainerInfraKubeconfigV1Read,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"auth_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  containerInfraV1KubeconfigClientCertificate,
+				ValidateFunc: validation.StringInSlice([]string{
+					containerInfraV1KubeconfigClientCertificate,
+					containerInfraV1KubeconfigKeystoneToken,
+					containerInfraV1KubeconfigExec,
+				}, false),
+			},
+
+			"common_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "admin",
+			},
+
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "system:masters",
+			},
+
+			"exec_command": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "client-keystone-auth",
+			},
+
+			"exec_args": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"exec_env": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ca_rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_ca_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"client_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"client_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"raw_config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceContainerInfraKubeconfigV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	cluster, err := clusters.Get(containerInfraClient, d.Get("cluster_id").(string)).Extract()
+	if err != nil {
+		return diag.Errorf("Error getting openstack_containerinfra_cluster_v1 %s: %s", d.Get("cluster_id").(string), err)
+	}
+
+	certificateAuthority, err := certificates.Get(containerInfraClient, cluster.UUID).Extract()
+	if err != nil {
+		return diag.Errorf("Error getting certificate authority of openstack_containerinfra_cluster_v1 %s: %s", cluster.UUID, err)
+	}
+
+	fingerprint, err := containerInfraV1CertificateFingerprint(certificateAuthority.PEM)
+	if err != nil {
+		return diag.Errorf("Error parsing certificate authority of openstack_containerinfra_cluster_v1 %s: %s", cluster.UUID, err)
+	}
+
+	var (
+		clientCertificate string
+		clientKey         []byte
+		token             string
+		user              kubernetesConfigUserData
+	)
+
+	authMode := d.Get("auth_mode").(string)
+	userName := fmt.Sprintf("%s-%s", cluster.Name, d.Get("common_name").(string))
+
+	switch authMode {
+	case containerInfraV1KubeconfigClientCertificate:
+		clientCertificate, clientKey, err = containerInfraV1CreateClientCertificate(containerInfraClient, cluster.UUID, d.Get("common_name").(string), d.Get("organization").(string))
+		if err != nil {
+			return diag.Errorf("Error creating client certificate for openstack_containerinfra_cluster_v1 %s: %s", cluster.UUID, err)
+		}
+
+		user = kubernetesConfigUserData{
+			ClientCertificateData: base64.StdEncoding.EncodeToString([]byte(clientCertificate)),
+			ClientKeyData:         base64.StdEncoding.EncodeToString(clientKey),
+		}
+	case containerInfraV1KubeconfigKeystoneToken:
+		// The Keystone webhook of the cluster validates the token of the
+		// provider, which expires together with the provider session.
+		token = containerInfraClient.Token()
+		userName = fmt.Sprintf("%s-keystone", cluster.Name)
+		user = kubernetesConfigUserData{
+			Token: token,
+		}
+	case containerInfraV1KubeconfigExec:
+		userName = fmt.Sprintf("%s-keystone", cluster.Name)
+		user = kubernetesConfigUserData{
+			Exec: expandContainerInfraV1KubeconfigExec(
+				d.Get("exec_command").(string),
+				containerInfraClient.IdentityEndpoint,
+				d.Get("exec_args").([]interface{}),
+				d.Get("exec_env").(map[string]interface{}),
+			),
+		}
+	}
+
+	rawKubeconfig, err := renderKubeconfigUser(cluster.Name, cluster.APIAddress, []byte(certificateAuthority.PEM), userName, user)
+	if err != nil {
+		return diag.Errorf("Error rendering kubeconfig for openstack_containerinfra_cluster_v1 %s: %s", cluster.UUID, err)
+	}
+
+	log.Printf("[DEBUG] Rendered %s kubeconfig for openstack_containerinfra_cluster_v1 %s", authMode, cluster.UUID)
+
+	d.SetId(cluster.UUID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("name", cluster.Name)
+	d.Set("host", cluster.APIAddress)
+	d.Set("cluster_ca_certificate", certificateAuthority.PEM)
+	d.Set("cluster_ca_fingerprint", fingerprint)
+	d.Set("client_certificate", clientCertificate)
+	d.Set("client_key", string(clientKey))
+	d.Set("token", token)
+	d.Set("raw_config", string(rawKubeconfig))
+
+	return nil
+}
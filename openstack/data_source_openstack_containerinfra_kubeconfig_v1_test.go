@@ -0,0 +1,80 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccContainerInfraV1KubeconfigDataSource_basic(t *testing.T) {
+	clusterName := acctest.RandomWithPrefix("tf-acc-cluster")
+	keypairName := acctest.RandomWithPrefix("tf-acc-keypair")
+	clusterTemplateName := acctest.RandomWithPrefix("tf-acc-clustertemplate")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckContainerInfraV1ClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerInfraV1KubeconfigDataSourceBasic(
+					testAccContainerInfraV1ClusterBasic(keypairName, clusterTemplateName, clusterName, 1),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_containerinfra_kubeconfig_v1.cert", "id",
+						"openstack_containerinfra_cluster_v1.cluster_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_containerinfra_kubeconfig_v1.cert", "name", clusterName),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_containerinfra_kubeconfig_v1.cert", "host"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_containerinfra_kubeconfig_v1.cert", "cluster_ca_certificate"),
+					resource.TestMatchResourceAttr(
+						"data.openstack_containerinfra_kubeconfig_v1.cert", "cluster_ca_fingerprint", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_containerinfra_kubeconfig_v1.cert", "client_certificate"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_containerinfra_kubeconfig_v1.cert", "client_key"),
+					resource.TestMatchResourceAttr(
+						"data.openstack_containerinfra_kubeconfig_v1.cert", "raw_config", regexp.MustCompile(`client-certificate-data`)),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_containerinfra_kubeconfig_v1.token", "token"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_containerinfra_kubeconfig_v1.token", "client_certificate", ""),
+					resource.TestMatchResourceAttr(
+						"data.openstack_containerinfra_kubeconfig_v1.token", "raw_config", regexp.MustCompile(`token: `)),
+					resource.TestMatchResourceAttr(
+						"data.openstack_containerinfra_kubeconfig_v1.exec", "raw_config", regexp.MustCompile(`command: client-keystone-auth`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainerInfraV1KubeconfigDataSourceBasic(clusterResource string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_containerinfra_kubeconfig_v1" "cert" {
+  cluster_id = "${openstack_containerinfra_cluster_v1.cluster_1.id}"
+}
+
+data "openstack_containerinfra_kubeconfig_v1" "token" {
+  cluster_id = "${openstack_containerinfra_cluster_v1.cluster_1.id}"
+  auth_mode  = "keystone_token"
+}
+
+data "openstack_containerinfra_kubeconfig_v1" "exec" {
+  cluster_id = "${openstack_containerinfra_cluster_v1.cluster_1.id}"
+  auth_mode  = "exec"
+  exec_args  = ["--domain-name=Default"]
+}
+`, clusterResource)
+}
@@ -0,0 +1,113 @@
+package openstack
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/utils/terraform/hashcode"
+)
+
+func dataSourceDatabaseConfigurationParametersV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDatabaseConfigurationParametersV1Read,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"datastore": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"min": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"max": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"restart_required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatabaseConfigurationParametersV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	datastore := expandDatabaseConfigurationV1Datastore(d.Get("datastore").([]interface{}))
+
+	params, err := databaseConfigurationV1Params(DatabaseV1Client, datastore.Type, datastore.Version)
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_db_configuration_parameters_v1: %s", err)
+	}
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parameters := make([]map[string]interface{}, len(names))
+	for i, name := range names {
+		p := params[name]
+		parameters[i] = map[string]interface{}{
+			"name":             p.Name,
+			"type":             p.Type,
+			"min":              p.Min,
+			"max":              p.Max,
+			"restart_required": p.RestartRequired,
+		}
+	}
+
+	d.SetId(hashcode.Strings(append([]string{datastore.Type, datastore.Version}, names...)))
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("parameters", parameters); err != nil {
+		return diag.Errorf("Unable to set openstack_db_configuration_parameters_v1 parameters: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatabaseV1ConfigurationParametersDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1ConfigurationParametersDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.openstack_db_configuration_parameters_v1.params", "parameters.#", regexp.MustCompile(`[1-9]\d*`)),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.openstack_db_configuration_parameters_v1.params", "parameters.*", map[string]string{
+							"name": "max_connections",
+							"type": "integer",
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseV1ConfigurationParametersDataSourceBasic() string {
+	return fmt.Sprintf(`
+data "openstack_db_configuration_parameters_v1" "params" {
+  datastore {
+    version = "%s"
+    type    = "%s"
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType)
+}
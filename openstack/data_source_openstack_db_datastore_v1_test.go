@@ -0,0 +1,41 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatabaseV1DatastoreDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1DatastoreDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_db_datastore_v1.datastore_1", "name", osDBDatastoreType),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_db_datastore_v1.datastore_1", "default_version"),
+					resource.TestMatchResourceAttr(
+						"data.openstack_db_datastore_v1.datastore_1", "versions.#", regexp.MustCompile(`[1-9]\d*`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseV1DatastoreDataSourceBasic() string {
+	return fmt.Sprintf(`
+data "openstack_db_datastore_v1" "datastore_1" {
+  name = "%s"
+}
+`, osDBDatastoreType)
+}
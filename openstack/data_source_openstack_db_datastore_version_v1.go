@@ -0,0 +1,71 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDatabaseDatastoreVersionV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDatabaseDatastoreVersionV1Read,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"datastore": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"datastore_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDatabaseDatastoreVersionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	DatabaseV1Client, err := config.DatabaseV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	datastore, err := databaseDatastoreV1Get(DatabaseV1Client, d.Get("datastore").(string))
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_db_datastore_version_v1: %s", err)
+	}
+
+	// Use the default version of the datastore, if no name was specified.
+	name := d.Get("name").(string)
+	if name == "" {
+		name = datastore.DefaultVersion
+	}
+
+	version, err := databaseDatastoreV1GetVersion(datastore, name)
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_db_datastore_version_v1: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_db_datastore_version_v1 %s: %#v", version.ID, version)
+
+	d.SetId(version.ID)
+	d.Set("name", version.Name)
+	d.Set("datastore_id", datastore.ID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
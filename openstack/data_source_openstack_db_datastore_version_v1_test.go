@@ -0,0 +1,50 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatabaseV1DatastoreVersionDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1DatastoreVersionDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_db_datastore_version_v1.version_1", "name", osDBDatastoreVersion),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_db_datastore_version_v1.version_1", "datastore_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_db_datastore_version_v1.default", "name",
+						"data.openstack_db_datastore_v1.datastore_1", "default_version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseV1DatastoreVersionDataSourceBasic() string {
+	return fmt.Sprintf(`
+data "openstack_db_datastore_v1" "datastore_1" {
+  name = "%[1]s"
+}
+
+data "openstack_db_datastore_version_v1" "version_1" {
+  datastore = "%[1]s"
+  name      = "%[2]s"
+}
+
+data "openstack_db_datastore_version_v1" "default" {
+  datastore = "${data.openstack_db_datastore_v1.datastore_1.id}"
+}
+`, osDBDatastoreType, osDBDatastoreVersion)
+}
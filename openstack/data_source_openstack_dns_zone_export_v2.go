@@ -0,0 +1,105 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func dataSourceDNSZoneExportV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSZoneExportV2Read,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone_file": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDNSZoneExportV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	task, err := dnsZoneExportV2Create(dnsClient, zoneID)
+	if err != nil {
+		return diag.Errorf("Error exporting openstack_dns_zone_v2 %s: %s", zoneID, err)
+	}
+
+	// The export task is only needed to render the zone file, so it is
+	// removed once it has been downloaded or has failed.
+	defer func() {
+		if err := dnsZoneExportV2Delete(dnsClient, task.ID); err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); !ok {
+				log.Printf("[WARN] Unable to delete export %s of openstack_dns_zone_v2 %s: %s", task.ID, zoneID, err)
+			}
+		}
+	}()
+
+	stateConf := &resource.StateChangeConf{
+		Target:  []string{"COMPLETE"},
+		Pending: []string{"PENDING"},
+		Refresh: dnsZoneTaskV2RefreshFunc("openstack_dns_zone_export_v2", func() (*dnsZoneTaskV2, error) {
+			return dnsZoneExportV2Get(dnsClient, task.ID)
+		}),
+		Timeout:    d.Timeout(schema.TimeoutRead),
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for export %s of openstack_dns_zone_v2 %s to complete: %s", task.ID, zoneID, err)
+	}
+
+	zoneFile, err := dnsZoneExportV2Download(dnsClient, task.ID)
+	if err != nil {
+		return diag.Errorf("Error downloading export %s of openstack_dns_zone_v2 %s: %s", task.ID, zoneID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved export %s of openstack_dns_zone_v2 %s", task.ID, zoneID)
+
+	d.SetId(zoneID)
+	d.Set("zone_file", zoneFile)
+	d.Set("project_id", task.ProjectID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
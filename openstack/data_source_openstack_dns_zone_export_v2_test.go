@@ -0,0 +1,59 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOpenStackDNSZoneExportV2DataSource_basic(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackDNSZoneExportV2DataSourceBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_zone_export_v2.export_1", "zone_id",
+						"openstack_dns_zone_v2.zone_1", "id"),
+					resource.TestMatchResourceAttr(
+						"data.openstack_dns_zone_export_v2.export_1", "zone_file",
+						regexp.MustCompile(regexp.QuoteMeta("www."+zoneName))),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackDNSZoneExportV2DataSourceBasic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "openstack_dns_zone_v2" "zone_1" {
+  name = "%[1]s"
+  email = "email1@example.com"
+  ttl = 3000
+  type = "PRIMARY"
+}
+
+resource "openstack_dns_recordset_v2" "recordset_1" {
+  zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+  name = "www.%[1]s"
+  type = "A"
+  records = ["10.1.0.1"]
+}
+
+data "openstack_dns_zone_export_v2" "export_1" {
+  zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+
+  depends_on = [openstack_dns_recordset_v2.recordset_1]
+}
+`, zoneName)
+}
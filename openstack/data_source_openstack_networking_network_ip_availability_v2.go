@@ -0,0 +1,168 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/networkipavailabilities"
+)
+
+func dataSourceNetworkingNetworkIPAvailabilityV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingNetworkIPAvailabilityV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"ip_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice([]int{4, 6}),
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"total_ips": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"used_ips": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"subnet_ip_availability": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_ips": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"used_ips": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingNetworkIPAvailabilityV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := networkipavailabilities.ListOpts{
+		NetworkID:   d.Get("network_id").(string),
+		NetworkName: d.Get("name").(string),
+		ProjectID:   d.Get("project_id").(string),
+	}
+
+	if v, ok := d.GetOk("ip_version"); ok {
+		listOpts.IPVersion = strconv.Itoa(v.(int))
+	}
+
+	pages, err := networkipavailabilities.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Unable to list openstack_networking_network_ip_availability_v2: %s", err)
+	}
+
+	allAvailabilities, err := networkipavailabilities.ExtractNetworkIPAvailabilities(pages)
+	if err != nil {
+		return diag.Errorf("Unable to retrieve openstack_networking_network_ip_availability_v2: %s", err)
+	}
+
+	if len(allAvailabilities) < 1 {
+		return diag.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allAvailabilities) > 1 {
+		return diag.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	availability := allAvailabilities[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_network_ip_availability_v2 %s: %+v", availability.NetworkID, availability)
+	d.SetId(availability.NetworkID)
+
+	d.Set("network_id", availability.NetworkID)
+	d.Set("name", availability.NetworkName)
+	d.Set("project_id", availability.ProjectID)
+	d.Set("total_ips", availability.TotalIPs)
+	d.Set("used_ips", availability.UsedIPs)
+	d.Set("region", GetRegion(d, config))
+
+	subnets := flattenNetworkingNetworkIPAvailabilityV2Subnets(availability.SubnetIPAvailabilities)
+	if err := d.Set("subnet_ip_availability", subnets); err != nil {
+		log.Printf("[DEBUG] Unable to set subnet_ip_availability for openstack_networking_network_ip_availability_v2 %s: %s", availability.NetworkID, err)
+	}
+
+	return nil
+}
+
+func flattenNetworkingNetworkIPAvailabilityV2Subnets(subnets []networkipavailabilities.SubnetIPAvailability) []map[string]interface{} {
+	res := make([]map[string]interface{}, len(subnets))
+	for i, s := range subnets {
+		res[i] = map[string]interface{}{
+			"subnet_id":   s.SubnetID,
+			"subnet_name": s.SubnetName,
+			"cidr":        s.CIDR,
+			"ip_version":  s.IPVersion,
+			"total_ips":   s.TotalIPs,
+			"used_ips":    s.UsedIPs,
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,66 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOpenStackNetworkingNetworkIPAvailabilityV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackNetworkingNetworkIPAvailabilityV2DataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "network_id",
+						"openstack_networking_network_v2.network_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "name", "network_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "total_ips", "253"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "used_ips"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "subnet_ip_availability.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "subnet_ip_availability.0.cidr", "192.168.199.0/24"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_ip_availability_v2.availability_1", "subnet_ip_availability.0.ip_version", "4"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackNetworkingNetworkIPAvailabilityV2DataSourceBasic = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  network_id = openstack_networking_network_v2.network_1.id
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  network_id = openstack_networking_network_v2.network_1.id
+
+  fixed_ip {
+    subnet_id = openstack_networking_subnet_v2.subnet_1.id
+  }
+}
+
+data "openstack_networking_network_ip_availability_v2" "availability_1" {
+  network_id = openstack_networking_port_v2.port_1.network_id
+  ip_version = 4
+}
+`
@@ -0,0 +1,69 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNetworkingV2QoSMinimumPacketRateRuleDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccSkipReleasesBelow(t, "stable/yoga")
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2QoSMinimumPacketRateRuleDataSource,
+			},
+			{
+				Config: testAccOpenStackNetworkingQoSMinimumPacketRateRuleV2DataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingQoSMinimumPacketRateRuleV2DataSourceID("data.openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1", "min_kpps", "3000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingQoSMinimumPacketRateRuleV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find QoS minimum packet rate data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("QoS minimum packet rate data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2QoSMinimumPacketRateRuleDataSource = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_minimum_packet_rate_rule_v2" "min_pps_rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  min_kpps      = 3000
+}
+`
+
+func testAccOpenStackNetworkingQoSMinimumPacketRateRuleV2DataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+data "openstack_networking_qos_minimum_packet_rate_rule_v2" "min_pps_rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  min_kpps      = "${openstack_networking_qos_minimum_packet_rate_rule_v2.min_pps_rule_1.min_kpps}"
+}
+`, testAccNetworkingV2QoSMinimumPacketRateRuleDataSource)
+}
@@ -0,0 +1,99 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNetworkingV2QoSPacketRateLimitRuleDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccSkipReleasesBelow(t, "stable/yoga")
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2QoSPacketRateLimitRuleDataSource,
+			},
+			{
+				Config: testAccOpenStackNetworkingQoSPacketRateLimitRuleV2DataSourceMaxKpps(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingQoSPacketRateLimitRuleV2DataSourceID("data.openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", "max_kpps", "3000"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", "max_burst_kpps", "300"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", "direction", "egress"),
+				),
+			},
+			{
+				Config: testAccOpenStackNetworkingQoSPacketRateLimitRuleV2DataSourceMaxBurstKpps(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingQoSPacketRateLimitRuleV2DataSourceID("data.openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", "max_kpps", "3000"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", "max_burst_kpps", "300"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", "direction", "egress"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingQoSPacketRateLimitRuleV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find QoS packet rate limit data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("QoS packet rate limit data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2QoSPacketRateLimitRuleDataSource = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_packet_rate_limit_rule_v2" "pps_limit_rule_1" {
+  qos_policy_id  = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  max_kpps       = 3000
+  max_burst_kpps = 300
+  direction      = "egress"
+}
+`
+
+func testAccOpenStackNetworkingQoSPacketRateLimitRuleV2DataSourceMaxKpps() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_qos_packet_rate_limit_rule_v2" "pps_limit_rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  max_kpps      = "${openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1.max_kpps}"
+}
+`, testAccNetworkingV2QoSPacketRateLimitRuleDataSource)
+}
+
+func testAccOpenStackNetworkingQoSPacketRateLimitRuleV2DataSourceMaxBurstKpps() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_qos_packet_rate_limit_rule_v2" "pps_limit_rule_1" {
+  qos_policy_id  = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  max_burst_kpps = "${openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1.max_burst_kpps}"
+}
+`, testAccNetworkingV2QoSPacketRateLimitRuleDataSource)
+}
@@ -0,0 +1,123 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+)
+
+func dataSourceObjectStorageContainerV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceObjectStorageContainerV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"container_read": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"container_write": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"storage_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"versioning": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"versions_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"history_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageContainerV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	result := containers.Get(objectStorageClient, name, nil)
+	if result.Err != nil {
+		return diag.Errorf("Error retrieving openstack_objectstorage_container_v1 %s: %s", name, result.Err)
+	}
+
+	headers, err := result.Extract()
+	if err != nil {
+		return diag.Errorf("Error extracting headers for openstack_objectstorage_container_v1 %s: %s", name, err)
+	}
+
+	metadata, err := result.ExtractMetadata()
+	if err != nil {
+		return diag.Errorf("Error extracting metadata for openstack_objectstorage_container_v1 %s: %s", name, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_objectstorage_container_v1 %s: %#v, %#v", name, headers, metadata)
+
+	d.SetId(name)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("container_read", strings.Join(headers.Read, ","))
+	d.Set("container_write", strings.Join(headers.Write, ","))
+	d.Set("storage_policy", headers.StoragePolicy)
+	d.Set("object_count", headers.ObjectCount)
+	d.Set("bytes_used", headers.BytesUsed)
+	d.Set("content_type", headers.ContentType)
+	d.Set("versioning", headers.VersionsEnabled)
+	d.Set("versions_location", headers.VersionsLocation)
+	d.Set("history_location", headers.HistoryLocation)
+	d.Set("metadata", metadata)
+
+	return nil
+}
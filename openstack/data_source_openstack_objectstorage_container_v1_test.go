@@ -0,0 +1,50 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObjectStorageV1ContainerDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_objectstorage_container_v1.container_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckObjectStorageV1ContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ContainerDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "container_1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "object_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bytes_used", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_policy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccObjectStorageV1ContainerDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_objectstorage_object_v1" "object_1" {
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  name           = "foo.txt"
+  content        = "foo"
+}
+
+data "openstack_objectstorage_container_v1" "container_1" {
+  name = "${openstack_objectstorage_object_v1.object_1.container_name}"
+}
+`, testAccObjectStorageV1ContainerBasic)
+}
@@ -0,0 +1,180 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+func dataSourceObjectStorageObjectV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceObjectStorageObjectV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"container_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"include_body": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"max_body_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1024 * 1024,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"body": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_encoding": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"delete_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"object_manifest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"static_large_object": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageObjectV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+	name := d.Get("name").(string)
+
+	result := objects.Get(objectStorageClient, cn, name, nil)
+	if result.Err != nil {
+		return diag.Errorf("Error retrieving openstack_objectstorage_object_v1 %s/%s: %s", cn, name, result.Err)
+	}
+
+	headers, err := result.Extract()
+	if err != nil {
+		return diag.Errorf("Error extracting headers for openstack_objectstorage_object_v1 %s/%s: %s", cn, name, err)
+	}
+
+	metadata, err := result.ExtractMetadata()
+	if err != nil {
+		return diag.Errorf("Error extracting metadata for openstack_objectstorage_object_v1 %s/%s: %s", cn, name, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_objectstorage_object_v1 %s/%s: %#v, %#v", cn, name, headers, metadata)
+
+	var body string
+	if d.Get("include_body").(bool) {
+		body, err = dataSourceObjectStorageObjectV1Body(objectStorageClient, cn, name, headers.ContentLength, int64(d.Get("max_body_size").(int)))
+		if err != nil {
+			return diag.Errorf("Error retrieving body of openstack_objectstorage_object_v1 %s/%s: %s", cn, name, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cn, name))
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("body", body)
+	d.Set("content_disposition", headers.ContentDisposition)
+	d.Set("content_encoding", headers.ContentEncoding)
+	d.Set("content_length", headers.ContentLength)
+	d.Set("content_type", headers.ContentType)
+	d.Set("etag", headers.ETag)
+	if headers.LastModified.Unix() > 0 {
+		d.Set("last_modified", headers.LastModified.Format(time.RFC3339))
+	}
+	if headers.DeleteAt.Unix() > 0 {
+		d.Set("delete_at", headers.DeleteAt.Format(time.RFC3339))
+	}
+	d.Set("object_manifest", headers.ObjectManifest)
+	d.Set("static_large_object", headers.StaticLargeObject)
+	d.Set("metadata", metadata)
+
+	return nil
+}
+
+// dataSourceObjectStorageObjectV1Body downloads the object body, which must
+// be valid UTF-8 text and not larger than maxSize.
+func dataSourceObjectStorageObjectV1Body(client *gophercloud.ServiceClient, containerName, objectName string, size, maxSize int64) (string, error) {
+	if size > maxSize {
+		return "", fmt.Errorf("The object size of %d bytes exceeds the max_body_size of %d bytes", size, maxSize)
+	}
+
+	result := objects.Download(client, containerName, objectName, nil)
+	content, err := result.ExtractContent()
+	if err != nil {
+		return "", err
+	}
+
+	return objectStorageObjectV1TextBody(content, maxSize)
+}
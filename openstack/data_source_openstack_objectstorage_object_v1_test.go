@@ -0,0 +1,76 @@
+package openstack
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccObjectStorageV1ObjectDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_objectstorage_object_v1.object_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckObjectStorageV1ObjectDestroy(s, "terraform/test/myfile.txt")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ObjectDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "tf_test_container_1/terraform/test/myfile.txt"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "3"),
+					resource.TestCheckResourceAttr(resourceName, "etag", fooMD5()),
+					resource.TestCheckResourceAttr(resourceName, "body", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+				),
+			},
+			{
+				Config:      testAccObjectStorageV1ObjectDataSourceTooLarge,
+				ExpectError: regexp.MustCompile("exceeds the max_body_size"),
+			},
+		},
+	})
+}
+
+const testAccObjectStorageV1ObjectDataSourceObject = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+}
+
+resource "openstack_objectstorage_object_v1" "myfile" {
+  name           = "terraform/test/myfile.txt"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content_type   = "text/plain"
+  content        = "foo"
+
+  metadata = {
+    test = "true"
+  }
+}
+`
+
+const testAccObjectStorageV1ObjectDataSourceBasic = testAccObjectStorageV1ObjectDataSourceObject + `
+data "openstack_objectstorage_object_v1" "object_1" {
+  container_name = "${openstack_objectstorage_object_v1.myfile.container_name}"
+  name           = "${openstack_objectstorage_object_v1.myfile.name}"
+  include_body   = true
+}
+`
+
+const testAccObjectStorageV1ObjectDataSourceTooLarge = testAccObjectStorageV1ObjectDataSourceObject + `
+data "openstack_objectstorage_object_v1" "object_1" {
+  container_name = "${openstack_objectstorage_object_v1.myfile.container_name}"
+  name           = "${openstack_objectstorage_object_v1.myfile.name}"
+  include_body   = true
+  max_body_size  = 2
+}
+`
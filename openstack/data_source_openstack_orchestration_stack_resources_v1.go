@@ -0,0 +1,157 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stackresources"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+	"github.com/gophercloud/utils/terraform/hashcode"
+)
+
+func dataSourceOrchestrationStackResourcesV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOrchestrationStackResourcesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"stack": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"nested_depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"stack_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"physical_resource_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceOrchestrationStackResourcesV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	orchestrationClient, err := config.OrchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack Orchestration client: %s", err)
+	}
+
+	stackRef := d.Get("stack").(string)
+	stack, err := stacks.Find(orchestrationClient, stackRef).Extract()
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_orchestration_stack_v1 %s: %s", stackRef, err)
+	}
+
+	nestedDepth := d.Get("nested_depth").(int)
+	listOpts := stackresources.ListOpts{
+		Depth: nestedDepth,
+	}
+
+	allPages, err := stackresources.List(orchestrationClient, stack.Name, stack.ID, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("Error listing openstack_orchestration_stack_resources_v1 of stack %s: %s", stack.ID, err)
+	}
+
+	allResources, err := stackresources.ExtractResources(allPages)
+	if err != nil {
+		return diag.Errorf("Error extracting openstack_orchestration_stack_resources_v1 of stack %s: %s", stack.ID, err)
+	}
+
+	resourceType := d.Get("resource_type").(string)
+	resources := make([]map[string]interface{}, 0, len(allResources))
+	physicalIDs := make([]string, 0, len(allResources))
+	for _, r := range allResources {
+		if resourceType != "" && r.Type != resourceType {
+			continue
+		}
+
+		resources = append(resources, map[string]interface{}{
+			"resource_name":        r.Name,
+			"logical_resource_id":  r.LogicalID,
+			"physical_resource_id": r.PhysicalID,
+			"resource_type":        r.Type,
+			"parent_resource":      r.ParentResource,
+			"status":               r.Status,
+			"status_reason":        r.StatusReason,
+		})
+
+		if r.PhysicalID != "" {
+			physicalIDs = append(physicalIDs, r.PhysicalID)
+		}
+	}
+
+	log.Printf("[DEBUG] Retrieved %d openstack_orchestration_stack_resources_v1 of stack %s", len(resources), stack.ID)
+
+	d.SetId(hashcode.Strings(append([]string{stack.ID, resourceType, strconv.Itoa(nestedDepth)}, physicalIDs...)))
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("stack_id", stack.ID)
+	d.Set("resources", resources)
+	d.Set("physical_resource_ids", physicalIDs)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOrchestrationV1StackResourcesDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_orchestration_stack_resources_v1.resources_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckOrchestrationV1StackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrchestrationV1StackBasic,
+			},
+			{
+				Config: testAccOrchestrationV1StackResourcesDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "stack_id", "openstack_orchestration_stack_v1.stack_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "physical_resource_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccOrchestrationV1StackResourcesDataSourceType(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resources.0.resource_name", "random"),
+					resource.TestCheckResourceAttr(resourceName, "resources.0.resource_type", "OS::Heat::RandomString"),
+					resource.TestCheckResourceAttrSet(resourceName, "resources.0.physical_resource_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrchestrationV1StackResourcesDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_orchestration_stack_resources_v1" "resources_1" {
+  stack = "${openstack_orchestration_stack_v1.stack_1.id}"
+}
+`, testAccOrchestrationV1StackBasic)
+}
+
+func testAccOrchestrationV1StackResourcesDataSourceType() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_orchestration_stack_resources_v1" "resources_1" {
+  stack         = "${openstack_orchestration_stack_v1.stack_1.name}"
+  resource_type = "OS::Heat::RandomString"
+  nested_depth  = 1
+}
+`, testAccOrchestrationV1StackBasic)
+}
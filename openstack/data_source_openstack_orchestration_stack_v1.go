@@ -0,0 +1,189 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+)
+
+func dataSourceOrchestrationStackV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOrchestrationStackV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"stack_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"stack_id"},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"template_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"disable_rollback": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"capabilities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"notification_topics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"outputs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"output_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"output_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"output_values": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOrchestrationStackV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	orchestrationClient, err := config.OrchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack Orchestration client: %s", err)
+	}
+
+	stackRef := d.Get("stack_id").(string)
+	if stackRef == "" {
+		stackRef = d.Get("name").(string)
+	}
+	if stackRef == "" {
+		return diag.Errorf("One of stack_id or name must be set for openstack_orchestration_stack_v1")
+	}
+
+	stack, err := stacks.Find(orchestrationClient, stackRef).Extract()
+	if err != nil {
+		return diag.Errorf("Error retrieving openstack_orchestration_stack_v1 %s: %s", stackRef, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_orchestration_stack_v1 %s: %#v", stack.ID, stack)
+
+	d.SetId(stack.ID)
+
+	params := stack.Parameters
+	for _, v := range []string{"OS::project_id", "OS::stack_id", "OS::stack_name"} {
+		delete(params, v)
+	}
+
+	outputs := flattenOrchestrationStackV1Outputs(stack.Outputs)
+	outputValues := make(map[string]string, len(outputs))
+	for _, o := range outputs {
+		k, _ := o["output_key"].(string)
+		v, _ := o["output_value"].(string)
+		if k != "" {
+			outputValues[k] = v
+		}
+	}
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("stack_id", stack.ID)
+	d.Set("name", stack.Name)
+	d.Set("description", stack.Description)
+	d.Set("template_description", stack.TemplateDescription)
+	d.Set("status", stack.Status)
+	d.Set("status_reason", stack.StatusReason)
+	d.Set("disable_rollback", stack.DisableRollback)
+	d.Set("timeout", stack.Timeout)
+	d.Set("parameters", params)
+	d.Set("tags", stack.Tags)
+	d.Set("capabilities", stack.Capabilities)
+	d.Set("notification_topics", stack.NotificationTopics)
+	d.Set("outputs", outputs)
+	d.Set("output_values", outputValues)
+	d.Set("creation_time", stack.CreationTime.Format(time.RFC3339))
+	d.Set("updated_time", stack.UpdatedTime.Format(time.RFC3339))
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOrchestrationV1StackDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_orchestration_stack_v1.stack_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckOrchestrationV1StackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrchestrationV1StackOutputs,
+			},
+			{
+				Config: testAccOrchestrationV1StackDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackDataSourceID(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "id", "openstack_orchestration_stack_v1.stack_5", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "stack_5"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.length", "4"),
+					resource.TestCheckResourceAttr(resourceName, "status", "CREATE_COMPLETE"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.0.output_key", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "output_values.value1", "foo"),
+				),
+			},
+			{
+				Config: testAccOrchestrationV1StackDataSourceID(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackDataSourceID(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "stack_5"),
+					resource.TestCheckResourceAttr(resourceName, "output_values.value1", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrchestrationV1StackDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find stack data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Stack data source ID is not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccOrchestrationV1StackDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_orchestration_stack_v1" "stack_1" {
+  name = "${openstack_orchestration_stack_v1.stack_5.name}"
+}
+`, testAccOrchestrationV1StackOutputs)
+}
+
+func testAccOrchestrationV1StackDataSourceID() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_orchestration_stack_v1" "stack_1" {
+  stack_id = "${openstack_orchestration_stack_v1.stack_5.id}"
+}
+`, testAccOrchestrationV1StackOutputs)
+}
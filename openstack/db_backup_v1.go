@@ -0,0 +1,93 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// databaseBackupV1CreateOpts represents the attributes used when creating a
+// new database backup.
+type databaseBackupV1CreateOpts struct {
+	Name        string `json:"name" required:"true"`
+	InstanceID  string `json:"instance" required:"true"`
+	Description string `json:"description,omitempty"`
+	ParentID    string `json:"parent_id,omitempty"`
+	Incremental int    `json:"incremental,omitempty"`
+}
+
+// databaseBackupV1Datastore represents the datastore of a database backup.
+type databaseBackupV1Datastore struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
+
+// databaseBackupV1 represents a database backup.
+type databaseBackupV1 struct {
+	ID          string                    `json:"id"`
+	Name        string                    `json:"name"`
+	Description string                    `json:"description"`
+	InstanceID  string                    `json:"instance_id"`
+	ParentID    string                    `json:"parent_id"`
+	Status      string                    `json:"status"`
+	Size        float64                   `json:"size"`
+	LocationRef string                    `json:"locationRef"`
+	Datastore   databaseBackupV1Datastore `json:"datastore"`
+	Created     string                    `json:"created"`
+	Updated     string                    `json:"updated"`
+}
+
+func databaseBackupV1Create(client *gophercloud.ServiceClient, opts databaseBackupV1CreateOpts) (*databaseBackupV1, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "backup")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Backup *databaseBackupV1 `json:"backup"`
+	}
+	_, err = client.Post(client.ServiceURL("backups"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return res.Backup, err
+}
+
+func databaseBackupV1Get(client *gophercloud.ServiceClient, id string) (*databaseBackupV1, error) {
+	var res struct {
+		Backup *databaseBackupV1 `json:"backup"`
+	}
+	_, err := client.Get(client.ServiceURL("backups", id), &res, nil)
+
+	return res.Backup, err
+}
+
+func databaseBackupV1Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("backups", id), &gophercloud.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+
+	return err
+}
+
+// databaseBackupV1StateRefreshFunc returns a resource.StateRefreshFunc
+// that is used to watch a database backup.
+func databaseBackupV1StateRefreshFunc(client *gophercloud.ServiceClient, backupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		b, err := databaseBackupV1Get(client, backupID)
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return b, "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		if b.Status == "FAILED" || b.Status == "DELETE_FAILED" {
+			return b, b.Status, fmt.Errorf("There was an error with the database backup %s: %s", backupID, b.Status)
+		}
+
+		return b, b.Status, nil
+	}
+}
@@ -1,9 +1,13 @@
 package openstack
 
 import (
+	"context"
+	"fmt"
+	"log"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/db/v1/configurations"
@@ -58,3 +62,147 @@ func databaseConfigurationV1StateRefreshFunc(client *gophercloud.ServiceClient,
 		return i, "ACTIVE", nil
 	}
 }
+
+// databaseConfigurationV1Params returns the configuration parameters, which
+// are supported by a datastore version, keyed by their name.
+func databaseConfigurationV1Params(client *gophercloud.ServiceClient, datastoreType, datastoreVersion string) (map[string]configurations.Param, error) {
+	datastore, err := databaseDatastoreV1Get(client, datastoreType)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := databaseDatastoreV1GetVersion(datastore, datastoreVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	allPages, err := configurations.ListDatastoreParams(client, datastore.ID, version.ID).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allParams, err := configurations.ExtractParams(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]configurations.Param, len(allParams))
+	for _, p := range allParams {
+		params[p.Name] = p
+	}
+
+	return params, nil
+}
+
+// databaseConfigurationV1ValidateValue checks, whether a configuration value
+// matches the type and range of the datastore configuration parameter.
+func databaseConfigurationV1ValidateValue(param configurations.Param, value string, stringType bool) error {
+	if stringType && param.Type != "string" {
+		return fmt.Errorf("%s is of type %s, string_type must not be set", param.Name, param.Type)
+	}
+
+	switch param.Type {
+	case "string":
+		if stringType {
+			return nil
+		}
+		// Values, which look like a number or a boolean, are converted
+		// by expandDatabaseConfigurationV1Values.
+		if _, err := strconv.Atoi(value); err == nil {
+			return fmt.Errorf("%s is of type string, string_type must be set for the value %q", param.Name, value)
+		}
+		if _, err := strconv.ParseBool(value); err == nil {
+			return fmt.Errorf("%s is of type string, string_type must be set for the value %q", param.Name, value)
+		}
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%s is of type boolean, got %q", param.Name, value)
+		}
+	case "integer", "float":
+		var v float64
+		if param.Type == "integer" {
+			i, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("%s is of type integer, got %q", param.Name, value)
+			}
+			v = float64(i)
+		} else {
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("%s is of type float, got %q", param.Name, value)
+			}
+			v = f
+		}
+
+		// Trove omits min and max for parameters without a range.
+		if param.Min == 0 && param.Max == 0 {
+			return nil
+		}
+		if v < param.Min || v > param.Max {
+			return fmt.Errorf("%s must be between %v and %v, got %s", param.Name, param.Min, param.Max, value)
+		}
+	}
+
+	return nil
+}
+
+// databaseConfigurationV1CustomizeDiff validates the configuration values
+// against the parameters of the datastore version at plan time. Unchanged
+// configurations aren't validated, so that retiring a datastore version
+// doesn't break the plan of existing resources.
+func databaseConfigurationV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("datastore") || !d.NewValueKnown("configuration") {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChange("configuration") && !d.HasChange("datastore") {
+		return nil
+	}
+
+	rawValues := d.Get("configuration").([]interface{})
+	if len(rawValues) == 0 {
+		return nil
+	}
+
+	rawDatastore := d.Get("datastore").([]interface{})
+	if len(rawDatastore) == 0 {
+		return nil
+	}
+	datastore := expandDatabaseConfigurationV1Datastore(rawDatastore)
+
+	config := meta.(*Config)
+	region := config.Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	DatabaseV1Client, err := config.DatabaseV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	params, err := databaseConfigurationV1Params(DatabaseV1Client, datastore.Type, datastore.Version)
+	if err != nil {
+		return fmt.Errorf("Error retrieving configuration parameters of datastore %s %s: %s", datastore.Type, datastore.Version, err)
+	}
+
+	for _, rawValue := range rawValues {
+		v := rawValue.(map[string]interface{})
+		name := v["name"].(string)
+		value := v["value"].(string)
+		stringType, _ := v["string_type"].(bool)
+
+		param, ok := params[name]
+		if !ok {
+			return fmt.Errorf("%s is not a configuration parameter of datastore %s %s", name, datastore.Type, datastore.Version)
+		}
+
+		if err := databaseConfigurationV1ValidateValue(param, value, stringType); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Validated openstack_db_configuration_v1 values against datastore %s %s", datastore.Type, datastore.Version)
+
+	return nil
+}
@@ -73,3 +73,33 @@ func TestUnitExpandDatabaseConfigurationV1Values(t *testing.T) {
 	actual := expandDatabaseConfigurationV1Values(values)
 	assert.Equal(t, expected, actual)
 }
+
+func TestUnitDatabaseConfigurationV1ValidateValue(t *testing.T) {
+	maxConnections := configurations.Param{
+		Name: "max_connections",
+		Type: "integer",
+		Min:  1,
+		Max:  100000,
+	}
+	autocommit := configurations.Param{
+		Name: "autocommit",
+		Type: "boolean",
+	}
+	collationServer := configurations.Param{
+		Name: "collation_server",
+		Type: "string",
+	}
+
+	assert.NoError(t, databaseConfigurationV1ValidateValue(maxConnections, "200", false))
+	assert.Error(t, databaseConfigurationV1ValidateValue(maxConnections, "0", false))
+	assert.Error(t, databaseConfigurationV1ValidateValue(maxConnections, "200000", false))
+	assert.Error(t, databaseConfigurationV1ValidateValue(maxConnections, "many", false))
+	assert.Error(t, databaseConfigurationV1ValidateValue(maxConnections, "200", true))
+
+	assert.NoError(t, databaseConfigurationV1ValidateValue(autocommit, "true", false))
+	assert.Error(t, databaseConfigurationV1ValidateValue(autocommit, "on", false))
+
+	assert.NoError(t, databaseConfigurationV1ValidateValue(collationServer, "latin1_swedish_ci", false))
+	assert.NoError(t, databaseConfigurationV1ValidateValue(collationServer, "47", true))
+	assert.Error(t, databaseConfigurationV1ValidateValue(collationServer, "47", false))
+}
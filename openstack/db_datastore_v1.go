@@ -0,0 +1,53 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/db/v1/datastores"
+)
+
+// databaseDatastoreV1Get retrieves a datastore by its name or ID.
+func databaseDatastoreV1Get(client *gophercloud.ServiceClient, nameOrID string) (*datastores.Datastore, error) {
+	allPages, err := datastores.List(client).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allDatastores, err := datastores.ExtractDatastores(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ds := range allDatastores {
+		if ds.ID == nameOrID || ds.Name == nameOrID {
+			return &ds, nil
+		}
+	}
+
+	return nil, fmt.Errorf("No datastore found with name or ID %s", nameOrID)
+}
+
+// databaseDatastoreV1GetVersion retrieves a version of a datastore by its
+// name or ID.
+func databaseDatastoreV1GetVersion(datastore *datastores.Datastore, nameOrID string) (*datastores.Version, error) {
+	for _, v := range datastore.Versions {
+		if v.ID == nameOrID || v.Name == nameOrID {
+			return &v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("No version found with name or ID %s for datastore %s", nameOrID, datastore.Name)
+}
+
+func flattenDatabaseDatastoreV1Versions(versions []datastores.Version) []map[string]interface{} {
+	res := make([]map[string]interface{}, len(versions))
+	for i, v := range versions {
+		res[i] = map[string]interface{}{
+			"id":   v.ID,
+			"name": v.Name,
+		}
+	}
+
+	return res
+}
@@ -1,7 +1,10 @@
 package openstack
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,6 +15,76 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/db/v1/users"
 )
 
+// DatabaseInstanceV1CreateOpts is a custom CreateOpts struct to include the
+// backup to restore the instance from and the primary instance of a replica.
+type DatabaseInstanceV1CreateOpts struct {
+	instances.CreateOpts
+	RestorePoint string
+	ReplicaOf    string
+}
+
+// ToInstanceCreateMap casts a CreateOpts struct to a map.
+// It overrides instances.ToInstanceCreateMap to add the RestorePoint and
+// ReplicaOf fields.
+func (opts DatabaseInstanceV1CreateOpts) ToInstanceCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToInstanceCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	instance := b["instance"].(map[string]interface{})
+	if opts.RestorePoint != "" {
+		instance["restorePoint"] = map[string]interface{}{
+			"backupRef": opts.RestorePoint,
+		}
+	}
+	if opts.ReplicaOf != "" {
+		instance["replica_of"] = opts.ReplicaOf
+	}
+
+	return b, nil
+}
+
+// databaseInstanceV1GetReplicaOf returns the ID of the primary instance of a
+// replica. The ID is empty if the instance is not a replica.
+func databaseInstanceV1GetReplicaOf(client *gophercloud.ServiceClient, id string) (string, error) {
+	var res struct {
+		Instance struct {
+			ReplicaOf *struct {
+				ID string `json:"id"`
+			} `json:"replica_of"`
+		} `json:"instance"`
+	}
+	if err := instances.Get(client, id).ExtractInto(&res); err != nil {
+		return "", err
+	}
+
+	if res.Instance.ReplicaOf == nil {
+		return "", nil
+	}
+
+	return res.Instance.ReplicaOf.ID, nil
+}
+
+// databaseInstanceV1WaitForActive waits for a database instance to finish a
+// pending operation, e.g. a build or a resize.
+func databaseInstanceV1WaitForActive(ctx context.Context, client *gophercloud.ServiceClient, id string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for openstack_db_instance_v1 %s to become available", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{"ACTIVE", "HEALTHY"},
+		Refresh:    databaseInstanceV1StateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 func expandDatabaseInstanceV1Datastore(rawDatastore []interface{}) instances.DatastoreOpts {
 	v := rawDatastore[0].(map[string]interface{})
 	datastore := instances.DatastoreOpts{
@@ -0,0 +1,97 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// dnsPTRRecordV2 represents a Designate PTR record of a floating IP.
+type dnsPTRRecordV2 struct {
+	ID          string `json:"id"`
+	PTRDName    string `json:"ptrdname"`
+	Description string `json:"description"`
+	TTL         int    `json:"ttl"`
+	Address     string `json:"address"`
+	Status      string `json:"status"`
+	Action      string `json:"action"`
+}
+
+// dnsPTRRecordV2SetOpts represents the attributes used when setting the PTR
+// record of a floating IP.
+type dnsPTRRecordV2SetOpts struct {
+	PTRDName    string `json:"ptrdname" required:"true"`
+	Description string `json:"description"`
+	TTL         int    `json:"ttl,omitempty"`
+}
+
+func dnsPTRRecordV2Get(client *gophercloud.ServiceClient, id string) (*dnsPTRRecordV2, error) {
+	var res dnsPTRRecordV2
+	_, err := client.Get(client.ServiceURL("reverse", "floatingips", id), &res, nil)
+
+	return &res, err
+}
+
+func dnsPTRRecordV2Set(client *gophercloud.ServiceClient, id string, opts dnsPTRRecordV2SetOpts) (*dnsPTRRecordV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var res dnsPTRRecordV2
+	_, err = client.Patch(client.ServiceURL("reverse", "floatingips", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return &res, err
+}
+
+// dnsPTRRecordV2Unset removes the PTR record of a floating IP by setting its
+// ptrdname to null.
+func dnsPTRRecordV2Unset(client *gophercloud.ServiceClient, id string) error {
+	b := map[string]interface{}{
+		"ptrdname": nil,
+	}
+
+	_, err := client.Patch(client.ServiceURL("reverse", "floatingips", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+
+	return err
+}
+
+func dnsPTRRecordV2RefreshFunc(dnsClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ptr, err := dnsPTRRecordV2Get(dnsClient, id)
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return ptr, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		// Designate keeps returning a floating IP without a PTR record, so
+		// an empty ptrdname, which is not pending, means it was removed.
+		if ptr.PTRDName == "" && ptr.Status != "PENDING" {
+			return ptr, "DELETED", nil
+		}
+
+		log.Printf("[DEBUG] openstack_dns_ptr_record_v2 %s current status: %s", id, ptr.Status)
+		return ptr, ptr.Status, nil
+	}
+}
+
+// dnsPTRRecordV2ParseID splits the region:floatingip_id ID of a PTR record.
+func dnsPTRRecordV2ParseID(id string) (string, string, error) {
+	idx := strings.LastIndex(id, ":")
+	if idx <= 0 || idx == len(id)-1 {
+		return "", "", fmt.Errorf("Unable to determine openstack_dns_ptr_record_v2 ID from raw ID: %s", id)
+	}
+
+	return id[:idx], id[idx+1:], nil
+}
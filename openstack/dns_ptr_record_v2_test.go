@@ -0,0 +1,24 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDNSPTRRecordV2ParseID(t *testing.T) {
+	region, floatingIPID, err := dnsPTRRecordV2ParseID("RegionOne:foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "RegionOne", region)
+	assert.Equal(t, "foo", floatingIPID)
+
+	region, floatingIPID, err = dnsPTRRecordV2ParseID("region:with:colons:foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "region:with:colons", region)
+	assert.Equal(t, "foo", floatingIPID)
+
+	for _, id := range []string{"foo", ":foo", "RegionOne:"} {
+		_, _, err = dnsPTRRecordV2ParseID(id)
+		assert.Error(t, err)
+	}
+}
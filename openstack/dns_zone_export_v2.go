@@ -0,0 +1,51 @@
+package openstack
+
+import (
+	"io"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func dnsZoneExportV2Create(client *gophercloud.ServiceClient, zoneID string) (*dnsZoneTaskV2, error) {
+	var res dnsZoneTaskV2
+	_, err := client.Post(client.ServiceURL("zones", zoneID, "tasks", "export"), map[string]interface{}{}, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return &res, err
+}
+
+func dnsZoneExportV2Get(client *gophercloud.ServiceClient, id string) (*dnsZoneTaskV2, error) {
+	var res dnsZoneTaskV2
+	_, err := client.Get(client.ServiceURL("zones", "tasks", "exports", id), &res, nil)
+
+	return &res, err
+}
+
+// dnsZoneExportV2Download returns the zone file rendered by a completed
+// export task.
+func dnsZoneExportV2Download(client *gophercloud.ServiceClient, id string) (string, error) {
+	resp, err := client.Get(client.ServiceURL("zones", "tasks", "exports", id, "export"), nil, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{
+			"Accept": "text/dns",
+		},
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func dnsZoneExportV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("zones", "tasks", "exports", id), nil)
+
+	return err
+}
@@ -0,0 +1,69 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// dnsZoneTaskV2 represents a Designate zone import or export task.
+type dnsZoneTaskV2 struct {
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	Message   string `json:"message"`
+	ZoneID    string `json:"zone_id"`
+	ProjectID string `json:"project_id"`
+	Location  string `json:"location"`
+	Version   int    `json:"version"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func dnsZoneImportV2Create(client *gophercloud.ServiceClient, zoneFile string) (*dnsZoneTaskV2, error) {
+	var res dnsZoneTaskV2
+	_, err := client.Post(client.ServiceURL("zones", "tasks", "imports"), strings.NewReader(zoneFile), &res, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{
+			"Content-Type": "text/dns",
+		},
+		OkCodes: []int{202},
+	})
+
+	return &res, err
+}
+
+func dnsZoneImportV2Get(client *gophercloud.ServiceClient, id string) (*dnsZoneTaskV2, error) {
+	var res dnsZoneTaskV2
+	_, err := client.Get(client.ServiceURL("zones", "tasks", "imports", id), &res, nil)
+
+	return &res, err
+}
+
+func dnsZoneImportV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("zones", "tasks", "imports", id), nil)
+
+	return err
+}
+
+// dnsZoneTaskV2RefreshFunc polls a zone import or export task using the
+// provided get function. A task in ERROR status is reported with its
+// message.
+func dnsZoneTaskV2RefreshFunc(resourceName string, get func() (*dnsZoneTaskV2, error)) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		task, err := get()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] %s %s current status: %s", resourceName, task.ID, task.Status)
+
+		if task.Status == "ERROR" {
+			return task, task.Status, fmt.Errorf("%s %s failed: %s", resourceName, task.ID, task.Message)
+		}
+
+		return task, task.Status, nil
+	}
+}
@@ -0,0 +1,39 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDNSZoneTaskV2RefreshFunc(t *testing.T) {
+	task := &dnsZoneTaskV2{
+		ID:     "foo",
+		Status: "PENDING",
+	}
+	refresh := dnsZoneTaskV2RefreshFunc("openstack_dns_zone_import_v2", func() (*dnsZoneTaskV2, error) {
+		return task, nil
+	})
+
+	_, status, err := refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "PENDING", status)
+
+	task.Status = "COMPLETE"
+	_, status, err = refresh()
+	assert.NoError(t, err)
+	assert.Equal(t, "COMPLETE", status)
+
+	task.Status = "ERROR"
+	task.Message = "invalid zone file"
+	_, status, err = refresh()
+	assert.EqualError(t, err, "openstack_dns_zone_import_v2 foo failed: invalid zone file")
+	assert.Equal(t, "ERROR", status)
+
+	refresh = dnsZoneTaskV2RefreshFunc("openstack_dns_zone_export_v2", func() (*dnsZoneTaskV2, error) {
+		return nil, fmt.Errorf("boom")
+	})
+	_, _, err = refresh()
+	assert.EqualError(t, err, "boom")
+}
@@ -0,0 +1,61 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// dnsZoneShareV2 represents the share of a Designate zone with another
+// project.
+type dnsZoneShareV2 struct {
+	ID              string `json:"id"`
+	ZoneID          string `json:"zone_id"`
+	ProjectID       string `json:"project_id"`
+	TargetProjectID string `json:"target_project_id"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+// dnsZoneShareV2CreateOpts represents the attributes used when sharing a
+// zone.
+type dnsZoneShareV2CreateOpts struct {
+	TargetProjectID string `json:"target_project_id" required:"true"`
+}
+
+func dnsZoneShareV2Create(client *gophercloud.ServiceClient, zoneID string, opts dnsZoneShareV2CreateOpts) (*dnsZoneShareV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var res dnsZoneShareV2
+	_, err = client.Post(client.ServiceURL("zones", zoneID, "shares"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+
+	return &res, err
+}
+
+func dnsZoneShareV2Get(client *gophercloud.ServiceClient, zoneID, shareID string) (*dnsZoneShareV2, error) {
+	var res dnsZoneShareV2
+	_, err := client.Get(client.ServiceURL("zones", zoneID, "shares", shareID), &res, nil)
+
+	return &res, err
+}
+
+func dnsZoneShareV2Delete(client *gophercloud.ServiceClient, zoneID, shareID string) error {
+	_, err := client.Delete(client.ServiceURL("zones", zoneID, "shares", shareID), nil)
+
+	return err
+}
+
+func dnsZoneShareV2ParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine openstack_dns_zone_share_v2 ID from raw ID: %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,19 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDNSZoneShareV2ParseID(t *testing.T) {
+	zoneID, shareID, err := dnsZoneShareV2ParseID("foo/bar")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", zoneID)
+	assert.Equal(t, "bar", shareID)
+
+	for _, id := range []string{"foo", "foo/", "/bar", "foo/bar/baz"} {
+		_, _, err = dnsZoneShareV2ParseID(id)
+		assert.Error(t, err)
+	}
+}
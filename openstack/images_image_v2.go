@@ -5,8 +5,11 @@ import (
 	"compress/gzip"
 	"context"
 	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
 	"log"
 	"net/http"
@@ -14,14 +17,18 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ulikunitz/xz"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/tasks"
 	"github.com/gophercloud/utils/terraform/mutexkv"
 )
 
@@ -55,38 +62,6 @@ func resourceImagesImageV2VisibilityFromString(v string) images.ImageVisibility
 	return ""
 }
 
-func fileMD5Checksum(f *os.File) (string, error) {
-	hash := md5.New()
-	if _, err := io.Copy(hash, f); err != nil {
-		return "", err
-	}
-	return hex.EncodeToString(hash.Sum(nil)), nil
-}
-
-func resourceImagesImageV2FileProps(filename string) (int64, string, error) {
-	var filesize int64
-	var filechecksum string
-
-	file, err := os.Open(filename)
-	if err != nil {
-		return -1, "", fmt.Errorf("Error opening file for Image: %s", err)
-	}
-	defer file.Close()
-
-	fstat, err := file.Stat()
-	if err != nil {
-		return -1, "", fmt.Errorf("Error reading image file %q: %s", file.Name(), err)
-	}
-
-	filesize = fstat.Size()
-	filechecksum, err = fileMD5Checksum(file)
-	if err != nil {
-		return -1, "", fmt.Errorf("Error computing image file %q checksum: %s", file.Name(), err)
-	}
-
-	return filesize, filechecksum, nil
-}
-
 func resourceImagesImageV2File(client *gophercloud.ServiceClient, d *schema.ResourceData, mutexKV *mutexkv.MutexKV) (string, error) {
 	if filename := d.Get("local_file_path").(string); filename != "" {
 		return filename, nil
@@ -138,68 +113,236 @@ func resourceImagesImageV2File(client *gophercloud.ServiceClient, d *schema.Reso
 	}
 	defer file.Close()
 
-	httpClient := &client.ProviderClient.HTTPClient
-	request, err := http.NewRequest("GET", furl, nil)
+	reader, err := imagesImageV2OpenSource(context.Background(), client, d)
 	if err != nil {
 		delFile()
-		return "", fmt.Errorf("Error creating a new request: %s", err)
+		return "", err
 	}
+	defer reader.Close()
 
-	username := d.Get("image_source_username").(string)
-	password := d.Get("image_source_password").(string)
-	if username != "" && password != "" {
-		request.SetBasicAuth(username, password)
+	if _, err = io.Copy(file, reader); err != nil {
+		delFile()
+		return "", fmt.Errorf("Error downloading image %q to file %q: %s", furl, filename, err)
 	}
 
-	resp, err := httpClient.Do(request)
+	return filename, nil
+}
+
+// imagesImageV2SourceReader reads the image from image_source_url. If the
+// download is interrupted, it is resumed with an HTTP range request starting
+// at the number of bytes already read.
+type imagesImageV2SourceReader struct {
+	ctx        context.Context
+	client     *http.Client
+	url        string
+	username   string
+	password   string
+	body       io.ReadCloser
+	header     http.Header
+	etag       string
+	offset     int64
+	resumes    int
+	maxResumes int
+}
+
+func (r *imagesImageV2SourceReader) open() error {
+	request, err := http.NewRequestWithContext(r.ctx, "GET", r.url, nil)
 	if err != nil {
-		delFile()
-		return "", fmt.Errorf("Error downloading image from %q: %s", furl, err)
+		return fmt.Errorf("Error creating a new request: %s", err)
+	}
+
+	if r.username != "" && r.password != "" {
+		request.SetBasicAuth(r.username, r.password)
+	}
+
+	if r.offset > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%d-", r.offset))
+		// Only resume, if the image hasn't changed in the meantime.
+		if r.etag != "" {
+			request.Header.Set("If-Range", r.etag)
+		}
+	}
+
+	resp, err := r.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("Error downloading image from %q: %s", r.url, err)
+	}
+
+	expected := http.StatusOK
+	if r.offset > 0 {
+		expected = http.StatusPartialContent
 	}
 
 	// check for credential error among other errors
-	if resp.StatusCode != http.StatusOK {
-		delFile()
-		return "", fmt.Errorf("Error downloading image from %q, statusCode is %d", furl, resp.StatusCode)
-	}
-
-	defer resp.Body.Close()
-	reader := resp.Body
-
-	decompress := d.Get("decompress").(bool)
-	if decompress {
-		// If we're here "Content-Encoding" in not filled, we'll read
-		// "Content-Type" to select format
-		switch resp.Header.Get("Content-Type") {
-		case "gzip", "application/gzip":
-			reader, err = gzip.NewReader(resp.Body)
-			if err != nil {
-				delFile()
-				return "", fmt.Errorf("Error decompressing gzip image: %s", err)
-			}
-		case "bzip2", "application/bzip2", "application/x-bzip2":
-			bz2Reader := bzip2.NewReader(resp.Body)
-			reader = io.NopCloser(bz2Reader)
-		case "xz", "application/xz", "application/x-xz":
-			xzReader, err := xz.NewReader(resp.Body)
-			if err != nil {
-				delFile()
-				return "", fmt.Errorf("Error decompressing xz image: %s", err)
-			}
-			reader = io.NopCloser(xzReader)
-		default:
-			delFile()
-			return "", fmt.Errorf("Error decompressing image, format %s is not supported", resp.Header.Get("Content-Type"))
+	if resp.StatusCode != expected {
+		resp.Body.Close()
+		return fmt.Errorf("Error downloading image from %q, statusCode is %d", r.url, resp.StatusCode)
+	}
+
+	if r.offset == 0 {
+		r.header = resp.Header
+		r.etag = resp.Header.Get("ETag")
+	}
+	r.body = resp.Body
+
+	return nil
+}
+
+func (r *imagesImageV2SourceReader) Read(p []byte) (int, error) {
+	n, err := r.body.Read(p)
+	r.offset += int64(n)
+
+	if err == nil || err == io.EOF || r.ctx.Err() != nil {
+		return n, err
+	}
+
+	if r.resumes >= r.maxResumes || r.header.Get("Accept-Ranges") != "bytes" {
+		return n, err
+	}
+
+	r.resumes++
+	log.Printf("[WARN] Download of image %q was interrupted after %d bytes: %s. Resuming (%d/%d)", r.url, r.offset, err, r.resumes, r.maxResumes)
+
+	r.body.Close()
+	if oerr := r.open(); oerr != nil {
+		return n, fmt.Errorf("%s (unable to resume: %s)", err, oerr)
+	}
+
+	return n, nil
+}
+
+func (r *imagesImageV2SourceReader) Close() error {
+	return r.body.Close()
+}
+
+// imagesImageV2OpenSource opens image_source_url for reading and decompresses
+// the image, if requested.
+func imagesImageV2OpenSource(ctx context.Context, client *gophercloud.ServiceClient, d *schema.ResourceData) (io.ReadCloser, error) {
+	source := &imagesImageV2SourceReader{
+		ctx:        ctx,
+		client:     &client.ProviderClient.HTTPClient,
+		url:        d.Get("image_source_url").(string),
+		username:   d.Get("image_source_username").(string),
+		password:   d.Get("image_source_password").(string),
+		maxResumes: imagesImageV2SourceMaxResumes,
+	}
+
+	if err := source.open(); err != nil {
+		return nil, err
+	}
+
+	if !d.Get("decompress").(bool) {
+		return source, nil
+	}
+
+	reader, err := imagesImageV2Decompress(source, source.header.Get("Content-Type"))
+	if err != nil {
+		source.Close()
+		return nil, err
+	}
+
+	return reader, nil
+}
+
+type imagesImageV2DecompressReader struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (r *imagesImageV2DecompressReader) Close() error {
+	var err error
+	for _, c := range r.closers {
+		if cerr := c.Close(); cerr != nil && err == nil {
+			err = cerr
 		}
-		defer reader.Close()
 	}
 
-	if _, err = io.Copy(file, reader); err != nil {
-		delFile()
-		return "", fmt.Errorf("Error downloading image %q to file %q: %s", furl, filename, err)
+	return err
+}
+
+func imagesImageV2Decompress(source io.ReadCloser, contentType string) (io.ReadCloser, error) {
+	// If we're here "Content-Encoding" in not filled, we'll read
+	// "Content-Type" to select format
+	switch contentType {
+	case "gzip", "application/gzip":
+		gzReader, err := gzip.NewReader(source)
+		if err != nil {
+			return nil, fmt.Errorf("Error decompressing gzip image: %s", err)
+		}
+		return &imagesImageV2DecompressReader{gzReader, []io.Closer{gzReader, source}}, nil
+	case "bzip2", "application/bzip2", "application/x-bzip2":
+		return &imagesImageV2DecompressReader{bzip2.NewReader(source), []io.Closer{source}}, nil
+	case "xz", "application/xz", "application/x-xz":
+		xzReader, err := xz.NewReader(source)
+		if err != nil {
+			return nil, fmt.Errorf("Error decompressing xz image: %s", err)
+		}
+		return &imagesImageV2DecompressReader{xzReader, []io.Closer{source}}, nil
 	}
 
-	return filename, nil
+	return nil, fmt.Errorf("Error decompressing image, format %s is not supported", contentType)
+}
+
+// imagesImageV2Hasher computes the checksums of the image data on the fly,
+// while it is uploaded.
+type imagesImageV2Hasher struct {
+	hashes map[string]hash.Hash
+	size   int64
+}
+
+func newImagesImageV2Hasher() *imagesImageV2Hasher {
+	return &imagesImageV2Hasher{
+		hashes: map[string]hash.Hash{
+			"md5":    md5.New(),
+			"sha256": sha256.New(),
+			"sha512": sha512.New(),
+		},
+	}
+}
+
+func (h *imagesImageV2Hasher) Write(p []byte) (int, error) {
+	for _, v := range h.hashes {
+		v.Write(p)
+	}
+	h.size += int64(len(p))
+
+	return len(p), nil
+}
+
+// Sum returns the hex encoded checksum of the data for the algorithm or an
+// empty string, if the algorithm isn't supported.
+func (h *imagesImageV2Hasher) Sum(algo string) string {
+	v, ok := h.hashes[algo]
+	if !ok {
+		return ""
+	}
+
+	return hex.EncodeToString(v.Sum(nil))
+}
+
+// imagesImageV2VerifyExpectedHash compares the checksum of the uploaded data
+// with expected_hash, which has the format <algorithm>:<hex value>.
+func imagesImageV2VerifyExpectedHash(expected string, h *imagesImageV2Hasher) error {
+	if expected == "" {
+		return nil
+	}
+
+	parts := strings.SplitN(expected, ":", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("expected_hash must have the format <algorithm>:<value>, got %q", expected)
+	}
+
+	algo, value := strings.ToLower(parts[0]), strings.ToLower(parts[1])
+	actual := h.Sum(algo)
+	if actual == "" {
+		return fmt.Errorf("expected_hash algorithm %q is not supported", algo)
+	}
+
+	if actual != value {
+		return fmt.Errorf("%s checksum mismatch: got %q, expected %q", algo, actual, value)
+	}
+
+	return nil
 }
 
 func resourceImagesImageV2RefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
@@ -372,3 +515,208 @@ func imagesFilterByProperties(v []images.Image, p map[string]string) []images.Im
 
 	return result
 }
+
+// imagesImageV2SourceMaxResumes is the number of times an interrupted
+// download of image_source_url is resumed.
+const imagesImageV2SourceMaxResumes = 5
+
+// imagesImageV2CopyImageMethod copies the data of an existing image into
+// additional stores.
+const imagesImageV2CopyImageMethod imageimport.ImportMethod = "copy-image"
+
+// imagesImageV2ImportOpts extends imageimport.CreateOpts with the multi-store
+// parameters of the interoperable image import API.
+type imagesImageV2ImportOpts struct {
+	Method               imageimport.ImportMethod
+	URI                  string
+	Stores               []string
+	AllStoresMustSucceed bool
+}
+
+func (opts imagesImageV2ImportOpts) ToImportCreateMap() (map[string]interface{}, error) {
+	method := map[string]interface{}{
+		"name": opts.Method,
+	}
+	if opts.URI != "" {
+		method["uri"] = opts.URI
+	}
+
+	b := map[string]interface{}{
+		"method": method,
+	}
+	if len(opts.Stores) > 0 {
+		b["stores"] = opts.Stores
+		b["all_stores_must_succeed"] = opts.AllStoresMustSucceed
+	}
+
+	return b, nil
+}
+
+// imagesImageV2ImportMethod returns the import method to create the image
+// with. An empty method means the image data is uploaded directly.
+func imagesImageV2ImportMethod(d *schema.ResourceData) imageimport.ImportMethod {
+	if v := d.Get("import_method").(string); v != "" {
+		return imageimport.ImportMethod(v)
+	}
+
+	if d.Get("web_download").(bool) {
+		return imageimport.WebDownloadMethod
+	}
+
+	// Direct uploads can't target specific stores.
+	if d.Get("stores").(*schema.Set).Len() > 0 {
+		return imageimport.GlanceDirectMethod
+	}
+
+	return ""
+}
+
+// imagesImageV2PropertyList splits a comma separated image property, such as
+// stores or os_glance_failed_import, into a list.
+func imagesImageV2PropertyList(properties map[string]interface{}, key string) []string {
+	v, ok := properties[key].(string)
+	if !ok || v == "" {
+		return []string{}
+	}
+
+	list := strings.Split(v, ",")
+	for i := range list {
+		list[i] = strings.TrimSpace(list[i])
+	}
+
+	return list
+}
+
+// imagesImageV2StoresRefreshFunc reports the image as "importing" as long as
+// Glance is importing the image into any of the stores.
+func imagesImageV2StoresRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		img, err := images.Get(client, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		importing := imagesImageV2PropertyList(img.Properties, "os_glance_importing_to_stores")
+		log.Printf("[DEBUG] OpenStack image %s is importing to stores: %v", id, importing)
+
+		if img.Status == images.ImageStatusKilled || img.Status == images.ImageStatusDeleted {
+			return img, string(img.Status), fmt.Errorf("image is in %s status", img.Status)
+		}
+
+		if len(importing) > 0 {
+			return img, "importing", nil
+		}
+
+		return img, "imported", nil
+	}
+}
+
+// imagesImageV2WaitForStores waits until the image import into the stores
+// has finished and returns the stores, the image failed to be imported into.
+func imagesImageV2WaitForStores(ctx context.Context, client *gophercloud.ServiceClient, id string, stores []string, timeout time.Duration) ([]string, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"importing"},
+		Target:     []string{"imported"},
+		Refresh:    imagesImageV2StoresRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	v, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	img := v.(*images.Image)
+	available := imagesImageV2PropertyList(img.Properties, "stores")
+
+	var failed []string
+	for _, store := range stores {
+		if !strSliceContains(available, store) {
+			failed = append(failed, store)
+		}
+	}
+
+	return failed, nil
+}
+
+// imagesImageV2ImportTaskMessage returns the message of the latest failed
+// import task of the image, if any.
+func imagesImageV2ImportTaskMessage(client *gophercloud.ServiceClient, id string) string {
+	var res struct {
+		Tasks []tasks.Task `json:"tasks"`
+	}
+
+	_, err := client.Get(client.ServiceURL("images", id, "tasks"), &res, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve tasks of image %s: %s", id, err)
+		return ""
+	}
+
+	var message string
+	var latest time.Time
+	for _, task := range res.Tasks {
+		if task.Status == string(tasks.TaskStatusFailure) && task.UpdatedAt.After(latest) {
+			latest = task.UpdatedAt
+			message = task.Message
+		}
+	}
+
+	return message
+}
+
+// imagesImageV2Import runs an image import and waits until the image has been
+// imported into all requested stores.
+func imagesImageV2Import(ctx context.Context, client *gophercloud.ServiceClient, id string, opts imagesImageV2ImportOpts, timeout time.Duration) diag.Diagnostics {
+	log.Printf("[DEBUG] Import Options: %#v", opts)
+	if err := imageimport.Create(client, id, opts).ExtractErr(); err != nil {
+		return diag.Errorf("Error while importing image %s with %s: %s", id, opts.Method, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(images.ImageStatusQueued), string(images.ImageStatusSaving), string(images.ImageStatusImporting), "uploading"},
+		Target:     []string{string(images.ImageStatusActive)},
+		Refresh:    resourceImagesImageV2RefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		if msg := imagesImageV2ImportTaskMessage(client, id); msg != "" {
+			return diag.Errorf("Error waiting for Image %s import: %s: %s", id, err, msg)
+		}
+		return diag.Errorf("Error waiting for Image %s import: %s", id, err)
+	}
+
+	if len(opts.Stores) == 0 {
+		return nil
+	}
+
+	failed, err := imagesImageV2WaitForStores(ctx, client, id, opts.Stores, timeout)
+	if err != nil {
+		return diag.Errorf("Error waiting for Image %s to be imported into stores: %s", id, err)
+	}
+
+	if len(failed) == 0 {
+		return nil
+	}
+
+	summary := fmt.Sprintf("Image %s failed to be imported into stores: %s", id, strings.Join(failed, ", "))
+	detail := imagesImageV2ImportTaskMessage(client, id)
+	if opts.AllStoresMustSucceed {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: summary, Detail: detail}}
+	}
+
+	return diag.Diagnostics{{Severity: diag.Warning, Summary: summary, Detail: detail}}
+}
+
+// imagesImageV2DeleteFromStore removes the image data from a single store.
+func imagesImageV2DeleteFromStore(client *gophercloud.ServiceClient, id, store string) error {
+	_, err := client.Delete(client.ServiceURL("stores", store, id), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+
+	return err
+}
@@ -0,0 +1,141 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
+)
+
+func TestUnitImagesImageV2ImportOptsToImportCreateMap(t *testing.T) {
+	opts := imagesImageV2ImportOpts{
+		Method: imageimport.WebDownloadMethod,
+		URI:    "https://example.com/image.img",
+	}
+
+	expected := map[string]interface{}{
+		"method": map[string]interface{}{
+			"name": imageimport.WebDownloadMethod,
+			"uri":  "https://example.com/image.img",
+		},
+	}
+
+	actual, err := opts.ToImportCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	opts = imagesImageV2ImportOpts{
+		Method:               imagesImageV2CopyImageMethod,
+		Stores:               []string{"ceph", "file"},
+		AllStoresMustSucceed: false,
+	}
+
+	expected = map[string]interface{}{
+		"method": map[string]interface{}{
+			"name": imagesImageV2CopyImageMethod,
+		},
+		"stores":                  []string{"ceph", "file"},
+		"all_stores_must_succeed": false,
+	}
+
+	actual, err = opts.ToImportCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitImagesImageV2PropertyList(t *testing.T) {
+	properties := map[string]interface{}{
+		"stores":                        "ceph, file",
+		"os_glance_importing_to_stores": "",
+		"hw_disk_bus":                   "scsi",
+	}
+
+	assert.Equal(t, []string{"ceph", "file"}, imagesImageV2PropertyList(properties, "stores"))
+	assert.Equal(t, []string{}, imagesImageV2PropertyList(properties, "os_glance_importing_to_stores"))
+	assert.Equal(t, []string{}, imagesImageV2PropertyList(properties, "os_glance_failed_import"))
+}
+
+func TestUnitImagesImageV2Hasher(t *testing.T) {
+	h := newImagesImageV2Hasher()
+	_, err := io.Copy(h, strings.NewReader("foo"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "acbd18db4cc2f85cedef654fccc4a4d8", h.Sum("md5"))
+	assert.Equal(t, "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", h.Sum("sha256"))
+	assert.Equal(t, "f7fbba6e0636f890e56fbbf3283e524c6fa3204ae298382d624741d0dc6638326e282c41be5e4254d8820772c5518a2c5a8c0c7f7eda19594a7eb539453e1ed7", h.Sum("sha512"))
+	assert.Equal(t, "", h.Sum("sha1"))
+	assert.Equal(t, int64(3), h.size)
+}
+
+func TestUnitImagesImageV2VerifyExpectedHash(t *testing.T) {
+	h := newImagesImageV2Hasher()
+	_, err := io.Copy(h, strings.NewReader("foo"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, imagesImageV2VerifyExpectedHash("", h))
+	assert.NoError(t, imagesImageV2VerifyExpectedHash("md5:ACBD18DB4CC2F85CEDEF654FCCC4A4D8", h))
+	assert.EqualError(t, imagesImageV2VerifyExpectedHash("md5:0000", h),
+		`md5 checksum mismatch: got "acbd18db4cc2f85cedef654fccc4a4d8", expected "0000"`)
+	assert.EqualError(t, imagesImageV2VerifyExpectedHash("sha1:0000", h),
+		`expected_hash algorithm "sha1" is not supported`)
+	assert.Error(t, imagesImageV2VerifyExpectedHash("0000", h))
+}
+
+func TestUnitImagesImageV2SourceReaderResume(t *testing.T) {
+	data := strings.Repeat("0123456789", 1000)
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Accept-Ranges", "bytes")
+		w.Header().Set("ETag", `"v1"`)
+
+		rangeHeader := r.Header.Get("Range")
+		if rangeHeader == "" {
+			// Announce the full length, but only send half of the data, so
+			// that the client gets an unexpected EOF.
+			w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			w.WriteHeader(http.StatusOK)
+			io.WriteString(w, data[:len(data)/2])
+			hj, _ := w.(http.Hijacker)
+			conn, _, _ := hj.Hijack()
+			conn.Close()
+			return
+		}
+
+		assert.Equal(t, `"v1"`, r.Header.Get("If-Range"))
+
+		var offset int
+		_, err := fmt.Sscanf(rangeHeader, "bytes=%d-", &offset)
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, len(data)-1, len(data)))
+		w.WriteHeader(http.StatusPartialContent)
+		io.WriteString(w, data[offset:])
+	}))
+	defer server.Close()
+
+	reader := &imagesImageV2SourceReader{
+		ctx:        context.Background(),
+		client:     server.Client(),
+		url:        server.URL,
+		maxResumes: imagesImageV2SourceMaxResumes,
+	}
+	assert.NoError(t, reader.open())
+	defer reader.Close()
+
+	actual, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, data, string(actual))
+	assert.Equal(t, 1, reader.resumes)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
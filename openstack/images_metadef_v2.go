@@ -0,0 +1,512 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// imagesMetadefV2ResourceTypeAssociation represents the association of a
+// Glance metadef namespace with a resource type, such as OS::Glance::Image.
+type imagesMetadefV2ResourceTypeAssociation struct {
+	Name             string `json:"name"`
+	Prefix           string `json:"prefix,omitempty"`
+	PropertiesTarget string `json:"properties_target,omitempty"`
+}
+
+// imagesMetadefV2Namespace represents a Glance metadef namespace.
+type imagesMetadefV2Namespace struct {
+	Namespace                string                                   `json:"namespace"`
+	DisplayName              string                                   `json:"display_name"`
+	Description              string                                   `json:"description"`
+	Visibility               string                                   `json:"visibility"`
+	Protected                bool                                     `json:"protected"`
+	Owner                    string                                   `json:"owner"`
+	CreatedAt                string                                   `json:"created_at"`
+	UpdatedAt                string                                   `json:"updated_at"`
+	ResourceTypeAssociations []imagesMetadefV2ResourceTypeAssociation `json:"resource_type_associations"`
+	Properties               map[string]imagesMetadefV2Property       `json:"properties"`
+	Objects                  []imagesMetadefV2NamespaceObject         `json:"objects"`
+}
+
+// imagesMetadefV2NamespaceOpts represents the attributes used when creating
+// or updating a metadef namespace.
+type imagesMetadefV2NamespaceOpts struct {
+	Namespace                string                                   `json:"namespace"`
+	DisplayName              string                                   `json:"display_name,omitempty"`
+	Description              string                                   `json:"description,omitempty"`
+	Visibility               string                                   `json:"visibility,omitempty"`
+	Protected                bool                                     `json:"protected"`
+	ResourceTypeAssociations []imagesMetadefV2ResourceTypeAssociation `json:"resource_type_associations,omitempty"`
+}
+
+// imagesMetadefV2PropertyItems describes the items of an array property.
+type imagesMetadefV2PropertyItems struct {
+	Type string        `json:"type,omitempty"`
+	Enum []interface{} `json:"enum,omitempty"`
+}
+
+// imagesMetadefV2Property represents a Glance metadef property, which is a
+// JSON schema describing a single image property or flavor extra spec.
+type imagesMetadefV2Property struct {
+	Name        string                        `json:"name,omitempty"`
+	Title       string                        `json:"title"`
+	Type        string                        `json:"type"`
+	Description string                        `json:"description,omitempty"`
+	Enum        []interface{}                 `json:"enum,omitempty"`
+	Default     interface{}                   `json:"default,omitempty"`
+	Minimum     *float64                      `json:"minimum,omitempty"`
+	Maximum     *float64                      `json:"maximum,omitempty"`
+	MinLength   *int                          `json:"minLength,omitempty"`
+	MaxLength   *int                          `json:"maxLength,omitempty"`
+	Pattern     string                        `json:"pattern,omitempty"`
+	ReadOnly    bool                          `json:"readonly,omitempty"`
+	Items       *imagesMetadefV2PropertyItems `json:"items,omitempty"`
+}
+
+// imagesMetadefV2NamespaceObject represents the properties of a metadef
+// object, which are embedded in a namespace.
+type imagesMetadefV2NamespaceObject struct {
+	Name       string                             `json:"name"`
+	Properties map[string]imagesMetadefV2Property `json:"properties"`
+}
+
+// imagesMetadefV2Object represents a Glance metadef object, which groups
+// several properties. The properties are kept as raw JSON schemas, so that
+// they are passed through unchanged.
+type imagesMetadefV2Object struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Required    []string               `json:"required,omitempty"`
+	Properties  map[string]interface{} `json:"properties,omitempty"`
+	CreatedAt   string                 `json:"created_at,omitempty"`
+	UpdatedAt   string                 `json:"updated_at,omitempty"`
+}
+
+func imagesMetadefV2NamespaceCreate(client *gophercloud.ServiceClient, opts imagesMetadefV2NamespaceOpts) (*imagesMetadefV2Namespace, error) {
+	var res imagesMetadefV2Namespace
+	_, err := client.Post(client.ServiceURL("metadefs", "namespaces"), opts, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+
+	return &res, err
+}
+
+// imagesMetadefV2NamespaceGet retrieves a metadef namespace. If resourceType
+// is set, the names of the returned properties contain the prefix of the
+// resource type association.
+func imagesMetadefV2NamespaceGet(client *gophercloud.ServiceClient, namespace, resourceType string) (*imagesMetadefV2Namespace, error) {
+	u := client.ServiceURL("metadefs", "namespaces", namespace)
+	if resourceType != "" {
+		q := url.Values{}
+		q.Set("resource_type", resourceType)
+		u += "?" + q.Encode()
+	}
+
+	var res imagesMetadefV2Namespace
+	_, err := client.Get(u, &res, nil)
+
+	return &res, err
+}
+
+func imagesMetadefV2NamespaceUpdate(client *gophercloud.ServiceClient, namespace string, opts imagesMetadefV2NamespaceOpts) (*imagesMetadefV2Namespace, error) {
+	var res imagesMetadefV2Namespace
+	_, err := client.Put(client.ServiceURL("metadefs", "namespaces", namespace), opts, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return &res, err
+}
+
+func imagesMetadefV2NamespaceDelete(client *gophercloud.ServiceClient, namespace string) error {
+	_, err := client.Delete(client.ServiceURL("metadefs", "namespaces", namespace), nil)
+	return err
+}
+
+// imagesMetadefV2NamespaceList lists the names of all metadef namespaces
+// associated with the resource type.
+func imagesMetadefV2NamespaceList(client *gophercloud.ServiceClient, resourceType string) ([]string, error) {
+	var names []string
+
+	q := url.Values{}
+	q.Set("resource_types", resourceType)
+	q.Set("limit", "100")
+
+	for {
+		var res struct {
+			Namespaces []imagesMetadefV2Namespace `json:"namespaces"`
+			Next       string                     `json:"next"`
+		}
+		_, err := client.Get(client.ServiceURL("metadefs", "namespaces")+"?"+q.Encode(), &res, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ns := range res.Namespaces {
+			names = append(names, ns.Namespace)
+		}
+
+		if res.Next == "" || len(res.Namespaces) == 0 {
+			return names, nil
+		}
+		q.Set("marker", res.Namespaces[len(res.Namespaces)-1].Namespace)
+	}
+}
+
+func imagesMetadefV2ResourceTypeAssociationCreate(client *gophercloud.ServiceClient, namespace string, opts imagesMetadefV2ResourceTypeAssociation) error {
+	_, err := client.Post(client.ServiceURL("metadefs", "namespaces", namespace, "resource_types"), opts, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+
+	return err
+}
+
+func imagesMetadefV2ResourceTypeAssociationDelete(client *gophercloud.ServiceClient, namespace, name string) error {
+	_, err := client.Delete(client.ServiceURL("metadefs", "namespaces", namespace, "resource_types", name), nil)
+	return err
+}
+
+func imagesMetadefV2ObjectCreate(client *gophercloud.ServiceClient, namespace string, opts imagesMetadefV2Object) (*imagesMetadefV2Object, error) {
+	var res imagesMetadefV2Object
+	_, err := client.Post(client.ServiceURL("metadefs", "namespaces", namespace, "objects"), opts, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+
+	return &res, err
+}
+
+func imagesMetadefV2ObjectGet(client *gophercloud.ServiceClient, namespace, name string) (*imagesMetadefV2Object, error) {
+	var res imagesMetadefV2Object
+	_, err := client.Get(client.ServiceURL("metadefs", "namespaces", namespace, "objects", name), &res, nil)
+
+	return &res, err
+}
+
+func imagesMetadefV2ObjectUpdate(client *gophercloud.ServiceClient, namespace, name string, opts imagesMetadefV2Object) (*imagesMetadefV2Object, error) {
+	var res imagesMetadefV2Object
+	_, err := client.Put(client.ServiceURL("metadefs", "namespaces", namespace, "objects", name), opts, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return &res, err
+}
+
+func imagesMetadefV2ObjectDelete(client *gophercloud.ServiceClient, namespace, name string) error {
+	_, err := client.Delete(client.ServiceURL("metadefs", "namespaces", namespace, "objects", name), nil)
+	return err
+}
+
+func imagesMetadefV2PropertyCreate(client *gophercloud.ServiceClient, namespace string, opts imagesMetadefV2Property) (*imagesMetadefV2Property, error) {
+	var res imagesMetadefV2Property
+	_, err := client.Post(client.ServiceURL("metadefs", "namespaces", namespace, "properties"), opts, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+
+	return &res, err
+}
+
+func imagesMetadefV2PropertyGet(client *gophercloud.ServiceClient, namespace, name string) (*imagesMetadefV2Property, error) {
+	var res imagesMetadefV2Property
+	_, err := client.Get(client.ServiceURL("metadefs", "namespaces", namespace, "properties", name), &res, nil)
+
+	return &res, err
+}
+
+func imagesMetadefV2PropertyUpdate(client *gophercloud.ServiceClient, namespace, name string, opts imagesMetadefV2Property) (*imagesMetadefV2Property, error) {
+	var res imagesMetadefV2Property
+	_, err := client.Put(client.ServiceURL("metadefs", "namespaces", namespace, "properties", name), opts, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return &res, err
+}
+
+func imagesMetadefV2PropertyDelete(client *gophercloud.ServiceClient, namespace, name string) error {
+	_, err := client.Delete(client.ServiceURL("metadefs", "namespaces", namespace, "properties", name), nil)
+	return err
+}
+
+func parseImagesMetadefV2ID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, "/", 2)
+	if len(idParts) < 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine metadef ID %s, expected <namespace>/<name>", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+func expandImagesMetadefV2ResourceTypeAssociations(v []interface{}) []imagesMetadefV2ResourceTypeAssociation {
+	associations := make([]imagesMetadefV2ResourceTypeAssociation, 0, len(v))
+	for _, raw := range v {
+		a := raw.(map[string]interface{})
+		associations = append(associations, imagesMetadefV2ResourceTypeAssociation{
+			Name:             a["name"].(string),
+			Prefix:           a["prefix"].(string),
+			PropertiesTarget: a["properties_target"].(string),
+		})
+	}
+
+	return associations
+}
+
+func flattenImagesMetadefV2ResourceTypeAssociations(associations []imagesMetadefV2ResourceTypeAssociation) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(associations))
+	for _, a := range associations {
+		result = append(result, map[string]interface{}{
+			"name":              a.Name,
+			"prefix":            a.Prefix,
+			"properties_target": a.PropertiesTarget,
+		})
+	}
+
+	return result
+}
+
+// imagesMetadefV2SuppressEquivalentJSON suppresses differences between
+// semantically equal JSON documents.
+func imagesMetadefV2SuppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
+	if diffSuppressJSONObject(k, old, new, d) {
+		return true
+	}
+
+	var o, n interface{}
+	if err := json.Unmarshal([]byte(old), &o); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &n); err != nil {
+		return false
+	}
+
+	ob, _ := json.Marshal(o)
+	nb, _ := json.Marshal(n)
+
+	return string(ob) == string(nb)
+}
+
+// imagesMetadefV2Schema returns the metadef properties of all namespaces
+// associated with the resource type, keyed by the property name including
+// the prefix of the resource type association.
+func imagesMetadefV2Schema(client *gophercloud.ServiceClient, resourceType string) (map[string]imagesMetadefV2Property, error) {
+	namespaces, err := imagesMetadefV2NamespaceList(client, resourceType)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing metadef namespaces for %s: %s", resourceType, err)
+	}
+
+	properties := make(map[string]imagesMetadefV2Property)
+	for _, name := range namespaces {
+		ns, err := imagesMetadefV2NamespaceGet(client, name, resourceType)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving metadef namespace %s: %s", name, err)
+		}
+
+		for k, v := range ns.Properties {
+			properties[k] = v
+		}
+		for _, object := range ns.Objects {
+			for k, v := range object.Properties {
+				properties[k] = v
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Retrieved %d metadef properties for %s", len(properties), resourceType)
+
+	return properties, nil
+}
+
+// imagesMetadefV2ValidateProperties validates the properties against the
+// metadef schema. Properties, which aren't described by the schema, are only
+// rejected, if their name is close to the name of a known property, since
+// they are most likely typos.
+func imagesMetadefV2ValidateProperties(metadefs map[string]imagesMetadefV2Property, properties map[string]string) error {
+	keys := make([]string, 0, len(properties))
+	for k := range properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	known := make([]string, 0, len(metadefs))
+	for k := range metadefs {
+		known = append(known, k)
+	}
+	sort.Strings(known)
+
+	var errs []string
+	for _, k := range keys {
+		property, ok := metadefs[k]
+		if !ok {
+			if suggestion := imagesMetadefV2Suggest(k, known); suggestion != "" {
+				errs = append(errs, fmt.Sprintf("%q is not a known property, did you mean %q?", k, suggestion))
+			}
+			continue
+		}
+
+		if err := imagesMetadefV2ValidateValue(property, properties[k]); err != nil {
+			errs = append(errs, fmt.Sprintf("%q: %s", k, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func imagesMetadefV2ValidateValue(property imagesMetadefV2Property, value string) error {
+	if len(property.Enum) > 0 && !imagesMetadefV2EnumContains(property.Enum, value) {
+		return fmt.Errorf("must be one of %s, got %q", imagesMetadefV2EnumString(property.Enum), value)
+	}
+
+	switch property.Type {
+	case "integer", "number":
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil || (property.Type == "integer" && n != float64(int64(n))) {
+			return fmt.Errorf("must be of type %s, got %q", property.Type, value)
+		}
+		if property.Minimum != nil && n < *property.Minimum {
+			return fmt.Errorf("must be at least %v, got %q", *property.Minimum, value)
+		}
+		if property.Maximum != nil && n > *property.Maximum {
+			return fmt.Errorf("must be at most %v, got %q", *property.Maximum, value)
+		}
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("must be a boolean, got %q", value)
+		}
+	case "string":
+		if property.MinLength != nil && len(value) < *property.MinLength {
+			return fmt.Errorf("must be at least %d characters long, got %q", *property.MinLength, value)
+		}
+		if property.MaxLength != nil && len(value) > *property.MaxLength {
+			return fmt.Errorf("must be at most %d characters long, got %q", *property.MaxLength, value)
+		}
+		if property.Pattern != "" {
+			re, err := regexp.Compile(property.Pattern)
+			if err != nil {
+				log.Printf("[DEBUG] Ignoring invalid metadef pattern %q: %s", property.Pattern, err)
+			} else if !re.MatchString(value) {
+				return fmt.Errorf("must match %q, got %q", property.Pattern, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+func imagesMetadefV2EnumContains(enum []interface{}, value string) bool {
+	for _, v := range enum {
+		if fmt.Sprint(v) == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func imagesMetadefV2EnumString(enum []interface{}) string {
+	values := make([]string, 0, len(enum))
+	for _, v := range enum {
+		values = append(values, fmt.Sprintf("%q", fmt.Sprint(v)))
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// imagesMetadefV2Suggest returns the known property name closest to name, if
+// it is at most two edits away.
+func imagesMetadefV2Suggest(name string, known []string) string {
+	var suggestion string
+	best := 3
+
+	for _, k := range known {
+		if d := imagesMetadefV2EditDistance(strings.ToLower(name), strings.ToLower(k)); d < best {
+			best = d
+			suggestion = k
+		}
+	}
+
+	return suggestion
+}
+
+// imagesMetadefV2EditDistance returns the Levenshtein distance of a and b.
+func imagesMetadefV2EditDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(minInt(prev[j]+1, curr[j-1]+1), prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// imagesMetadefV2CustomizeDiffValidate returns a CustomizeDiff function,
+// which validates the attribute against the metadef schema of the resource
+// type, if the validation is enabled by the toggle attribute.
+func imagesMetadefV2CustomizeDiffValidate(attr, toggle, resourceType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.Get(toggle).(bool) || !d.NewValueKnown(attr) {
+			return nil
+		}
+
+		if d.Id() != "" && !d.HasChange(attr) && !d.HasChange(toggle) {
+			return nil
+		}
+
+		properties := expandToMapStringString(d.Get(attr).(map[string]interface{}))
+		if len(properties) == 0 {
+			return nil
+		}
+
+		config := meta.(*Config)
+		region := config.Region
+		if v, ok := d.GetOk("region"); ok {
+			region = v.(string)
+		}
+
+		imageClient, err := config.ImageV2Client(region)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack image client: %s", err)
+		}
+
+		metadefs, err := imagesMetadefV2Schema(imageClient, resourceType)
+		if err != nil {
+			return err
+		}
+
+		if err := imagesMetadefV2ValidateProperties(metadefs, properties); err != nil {
+			return fmt.Errorf("Invalid %s: %s", attr, err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,91 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitImagesMetadefV2ValidateProperties(t *testing.T) {
+	minimum := float64(1)
+	maximum := float64(16)
+	maxLength := 8
+
+	metadefs := map[string]imagesMetadefV2Property{
+		"hw_disk_bus": {
+			Type: "string",
+			Enum: []interface{}{"scsi", "virtio", "ide"},
+		},
+		"hw_vif_multiqueue_enabled": {
+			Type: "boolean",
+		},
+		"hw_watchdog_queues": {
+			Type:    "integer",
+			Minimum: &minimum,
+			Maximum: &maximum,
+		},
+		"os_distro": {
+			Type:      "string",
+			MaxLength: &maxLength,
+			Pattern:   "^[a-z]+$",
+		},
+	}
+
+	valid := map[string]string{
+		"hw_disk_bus":               "scsi",
+		"hw_vif_multiqueue_enabled": "true",
+		"hw_watchdog_queues":        "4",
+		"os_distro":                 "ubuntu",
+		"custom_property":           "anything",
+	}
+	assert.NoError(t, imagesMetadefV2ValidateProperties(metadefs, valid))
+
+	invalid := map[string]string{
+		"hw_disk_bus":               "sata",
+		"hw_vif_multiqueue_enabled": "yes",
+		"hw_watchdog_queues":        "1.5",
+		"os_distro":                 "Ubuntu",
+	}
+	err := imagesMetadefV2ValidateProperties(metadefs, invalid)
+	assert.EqualError(t, err, `"hw_disk_bus": must be one of "scsi", "virtio", "ide", got "sata"; `+
+		`"hw_vif_multiqueue_enabled": must be a boolean, got "yes"; `+
+		`"hw_watchdog_queues": must be of type integer, got "1.5"; `+
+		`"os_distro": must match "^[a-z]+$", got "Ubuntu"`)
+
+	outOfRange := map[string]string{
+		"hw_watchdog_queues": "32",
+		"os_distro":          "debianlinux",
+	}
+	err = imagesMetadefV2ValidateProperties(metadefs, outOfRange)
+	assert.EqualError(t, err, `"hw_watchdog_queues": must be at most 16, got "32"; `+
+		`"os_distro": must be at most 8 characters long, got "debianlinux"`)
+
+	typo := map[string]string{
+		"hw_disk_buss": "scsi",
+	}
+	err = imagesMetadefV2ValidateProperties(metadefs, typo)
+	assert.EqualError(t, err, `"hw_disk_buss" is not a known property, did you mean "hw_disk_bus"?`)
+}
+
+func TestUnitImagesMetadefV2EditDistance(t *testing.T) {
+	assert.Equal(t, 0, imagesMetadefV2EditDistance("hw_disk_bus", "hw_disk_bus"))
+	assert.Equal(t, 1, imagesMetadefV2EditDistance("hw_disk_buss", "hw_disk_bus"))
+	assert.Equal(t, 2, imagesMetadefV2EditDistance("hw:cpu_polcy", "hw:cpu_policy_"))
+	assert.Equal(t, 3, imagesMetadefV2EditDistance("", "abc"))
+}
+
+func TestUnitParseImagesMetadefV2ID(t *testing.T) {
+	namespace, name, err := parseImagesMetadefV2ID("OS::Compute::Watchdog/Watchdog")
+	assert.NoError(t, err)
+	assert.Equal(t, "OS::Compute::Watchdog", namespace)
+	assert.Equal(t, "Watchdog", name)
+
+	_, _, err = parseImagesMetadefV2ID("OS::Compute::Watchdog")
+	assert.Error(t, err)
+}
+
+func TestUnitImagesMetadefV2SuppressEquivalentJSON(t *testing.T) {
+	assert.True(t, imagesMetadefV2SuppressEquivalentJSON("properties", `{"a": {"type": "string", "title": "A"}}`, `{"a":{"title":"A","type":"string"}}`, nil))
+	assert.True(t, imagesMetadefV2SuppressEquivalentJSON("properties", "", "{}", nil))
+	assert.False(t, imagesMetadefV2SuppressEquivalentJSON("properties", `{"a": {"type": "string"}}`, `{"a": {"type": "integer"}}`, nil))
+}
@@ -27,6 +27,9 @@ func TestAccComputeV2Flavor_importBasic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"validate_extra_specs",
+				},
 			},
 		},
 	})
@@ -0,0 +1,36 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatabaseV1Backup_importBasic(t *testing.T) {
+	resourceName := "openstack_db_backup_v1.backup_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1BackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1BackupBasic(),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"region",
+					"incremental",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDNSV2PTRRecord_importBasic(t *testing.T) {
+	ptrName := fmt.Sprintf("ACPTTEST-ptr-%s.com.", acctest.RandString(5))
+	resourceName := "openstack_dns_ptr_record_v2.ptr_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2PTRRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2PTRRecordBasic(ptrName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"disable_status_check",
+				},
+			},
+		},
+	})
+}
@@ -32,6 +32,10 @@ func TestAccImagesImageV2_importBasic(t *testing.T) {
 					"image_source_url",
 					"verify_checksum",
 					"decompress",
+					"all_stores_must_succeed",
+					"validate_properties",
+					"stream_upload",
+					"expected_hash",
 				},
 			},
 		},
@@ -0,0 +1,57 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworkingV2LocalIP_importBasic(t *testing.T) {
+	resourceName := "openstack_networking_local_ip_v2.local_ip_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccSkipReleasesBelow(t, "stable/yoga")
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2LocalIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LocalIPBasic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2LocalIPAssociate_importBasic(t *testing.T) {
+	resourceName := "openstack_networking_local_ip_associate_v2.associate_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccSkipReleasesBelow(t, "stable/yoga")
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2LocalIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LocalIPAssociateBasic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
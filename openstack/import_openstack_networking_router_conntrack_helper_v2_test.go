@@ -0,0 +1,32 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetworkingV2RouterConntrackHelper_importBasic(t *testing.T) {
+	resourceName := "openstack_networking_router_conntrack_helper_v2.helper_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccSkipReleasesBelow(t, "stable/ussuri")
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2RouterConntrackHelperDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2RouterConntrackHelperBasic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
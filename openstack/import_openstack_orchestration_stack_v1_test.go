@@ -27,6 +27,9 @@ func TestAccOrchestrationStackV1_importBasic(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"environment_opts",
 					"template_opts",
+					"abandon_on_destroy",
+					"preview_updates",
+					"check_trigger",
 				},
 			},
 		},
@@ -0,0 +1,170 @@
+package openstack
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// localIPV2 represents a Neutron Local IP.
+type localIPV2 struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	ProjectID      string `json:"project_id"`
+	LocalPortID    string `json:"local_port_id"`
+	NetworkID      string `json:"network_id"`
+	LocalIPAddress string `json:"local_ip_address"`
+	IPMode         string `json:"ip_mode"`
+}
+
+// localIPV2CreateOpts represents the attributes used when creating a new
+// Local IP.
+type localIPV2CreateOpts struct {
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+	ProjectID      string `json:"project_id,omitempty"`
+	LocalPortID    string `json:"local_port_id,omitempty"`
+	NetworkID      string `json:"network_id,omitempty"`
+	LocalIPAddress string `json:"local_ip_address,omitempty"`
+	IPMode         string `json:"ip_mode,omitempty"`
+}
+
+// localIPV2UpdateOpts represents the attributes used when updating an
+// existing Local IP.
+type localIPV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// localIPV2PortAssociation represents the association of a Local IP with a
+// fixed port.
+type localIPV2PortAssociation struct {
+	LocalIPID      string `json:"local_ip_id"`
+	LocalIPAddress string `json:"local_ip_address"`
+	FixedPortID    string `json:"fixed_port_id"`
+	FixedIP        string `json:"fixed_ip"`
+	Host           string `json:"host"`
+}
+
+// localIPV2PortAssociationCreateOpts represents the attributes used when
+// associating a Local IP with a fixed port.
+type localIPV2PortAssociationCreateOpts struct {
+	FixedPortID string `json:"fixed_port_id" required:"true"`
+	FixedIP     string `json:"fixed_ip,omitempty"`
+}
+
+func localIPV2Create(client *gophercloud.ServiceClient, opts localIPV2CreateOpts) (*localIPV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "local_ip")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		LocalIP *localIPV2 `json:"local_ip"`
+	}
+	_, err = client.Post(client.ServiceURL("local_ips"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+
+	return res.LocalIP, err
+}
+
+func localIPV2Get(client *gophercloud.ServiceClient, id string) (*localIPV2, error) {
+	var res struct {
+		LocalIP *localIPV2 `json:"local_ip"`
+	}
+	_, err := client.Get(client.ServiceURL("local_ips", id), &res, nil)
+
+	return res.LocalIP, err
+}
+
+func localIPV2Update(client *gophercloud.ServiceClient, id string, opts localIPV2UpdateOpts) (*localIPV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "local_ip")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		LocalIP *localIPV2 `json:"local_ip"`
+	}
+	_, err = client.Put(client.ServiceURL("local_ips", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return res.LocalIP, err
+}
+
+func localIPV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("local_ips", id), nil)
+	return err
+}
+
+func localIPV2PortAssociationCreate(client *gophercloud.ServiceClient, localIPID string, opts localIPV2PortAssociationCreateOpts) (*localIPV2PortAssociation, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "port_association")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		PortAssociation *localIPV2PortAssociation `json:"port_association"`
+	}
+	_, err = client.Post(client.ServiceURL("local_ips", localIPID, "port_associations"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+
+	return res.PortAssociation, err
+}
+
+// localIPV2PortAssociationGet looks up the association of a Local IP with the
+// given fixed port. Neutron doesn't provide a single association lookup, so
+// the associations of the Local IP are filtered by the fixed port.
+func localIPV2PortAssociationGet(client *gophercloud.ServiceClient, localIPID, fixedPortID string) (*localIPV2PortAssociation, error) {
+	q := url.Values{}
+	q.Set("fixed_port_id", fixedPortID)
+	u := client.ServiceURL("local_ips", localIPID, "port_associations") + "?" + q.Encode()
+
+	var res struct {
+		PortAssociations []localIPV2PortAssociation `json:"port_associations"`
+	}
+	_, err := client.Get(u, &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range res.PortAssociations {
+		if a.FixedPortID == fixedPortID {
+			return &a, nil
+		}
+	}
+
+	return nil, gophercloud.ErrDefault404{
+		ErrUnexpectedResponseCode: gophercloud.ErrUnexpectedResponseCode{
+			URL:      u,
+			Method:   "GET",
+			Expected: []int{200},
+			Actual:   404,
+			Body:     []byte(fmt.Sprintf("Local IP %s is not associated with port %s", localIPID, fixedPortID)),
+		},
+	}
+}
+
+func localIPV2PortAssociationDelete(client *gophercloud.ServiceClient, localIPID, fixedPortID string) error {
+	_, err := client.Delete(client.ServiceURL("local_ips", localIPID, "port_associations", fixedPortID), nil)
+	return err
+}
+
+func resourceNetworkingLocalIPAssociateV2BuildID(localIPID, fixedPortID string) string {
+	return fmt.Sprintf("%s/%s", localIPID, fixedPortID)
+}
+
+func resourceNetworkingLocalIPAssociateV2ParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format: %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
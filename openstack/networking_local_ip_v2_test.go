@@ -0,0 +1,22 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceNetworkingLocalIPAssociateV2ParseID(t *testing.T) {
+	localIPID, fixedPortID, err := resourceNetworkingLocalIPAssociateV2ParseID("local_ip/port")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "local_ip", localIPID)
+	assert.Equal(t, "port", fixedPortID)
+}
+
+func TestUnitResourceNetworkingLocalIPAssociateV2ParseIDInvalid(t *testing.T) {
+	for _, id := range []string{"local_ip", "local_ip/", "/port", "local_ip/port/extra"} {
+		_, _, err := resourceNetworkingLocalIPAssociateV2ParseID(id)
+		assert.Error(t, err, id)
+	}
+}
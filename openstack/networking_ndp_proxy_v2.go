@@ -0,0 +1,79 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ndpProxyV2 represents a Neutron router NDP proxy.
+type ndpProxyV2 struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	RouterID    string `json:"router_id"`
+	PortID      string `json:"port_id"`
+	IPAddress   string `json:"ip_address"`
+	ProjectID   string `json:"project_id"`
+}
+
+// ndpProxyV2CreateOpts represents the attributes used when creating a new
+// NDP proxy.
+type ndpProxyV2CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	RouterID    string `json:"router_id" required:"true"`
+	PortID      string `json:"port_id" required:"true"`
+	IPAddress   string `json:"ip_address,omitempty"`
+}
+
+// ndpProxyV2UpdateOpts represents the attributes used when updating an
+// existing NDP proxy.
+type ndpProxyV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+func ndpProxyV2Create(client *gophercloud.ServiceClient, opts ndpProxyV2CreateOpts) (*ndpProxyV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "ndp_proxy")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		NDPProxy *ndpProxyV2 `json:"ndp_proxy"`
+	}
+	_, err = client.Post(client.ServiceURL("ndp_proxies"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+
+	return res.NDPProxy, err
+}
+
+func ndpProxyV2Get(client *gophercloud.ServiceClient, id string) (*ndpProxyV2, error) {
+	var res struct {
+		NDPProxy *ndpProxyV2 `json:"ndp_proxy"`
+	}
+	_, err := client.Get(client.ServiceURL("ndp_proxies", id), &res, nil)
+
+	return res.NDPProxy, err
+}
+
+func ndpProxyV2Update(client *gophercloud.ServiceClient, id string, opts ndpProxyV2UpdateOpts) (*ndpProxyV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "ndp_proxy")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		NDPProxy *ndpProxyV2 `json:"ndp_proxy"`
+	}
+	_, err = client.Put(client.ServiceURL("ndp_proxies", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return res.NDPProxy, err
+}
+
+func ndpProxyV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("ndp_proxies", id), nil)
+	return err
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -237,3 +239,122 @@ func flattenNetworkingPortBindingV2(port portExtended) interface{} {
 
 	return portBinding
 }
+
+// networkingPortV2BindingProfileComputedKeys are the binding profile keys,
+// which Nova fills in when it binds a port, e.g. an SR-IOV port.
+var networkingPortV2BindingProfileComputedKeys = []string{
+	"pci_slot",
+	"pci_vendor_info",
+	"physical_network",
+	"card_serial_number",
+	"pf_mac_address",
+	"vf_num",
+}
+
+var (
+	networkingPortV2BindingProfilePCISlotRegexp    = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-7]$`)
+	networkingPortV2BindingProfileVendorInfoRegexp = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{4}$`)
+)
+
+// validateNetworkingPortV2BindingProfile validates a binding profile JSON
+// object. Besides the JSON syntax, the well-known keys used by SR-IOV,
+// OVS hardware offload and baremetal ports are checked for their types and
+// formats. Unknown keys are passed through as is.
+func validateNetworkingPortV2BindingProfile(v interface{}, k string) ([]string, []error) {
+	if ws, errs := validateJSONObject(v, k); len(errs) > 0 {
+		return ws, errs
+	}
+
+	var profile map[string]interface{}
+	_ = json.Unmarshal([]byte(v.(string)), &profile)
+
+	var errs []error
+	for key, value := range profile {
+		switch key {
+		case "pci_slot":
+			s, ok := value.(string)
+			if !ok || !networkingPortV2BindingProfilePCISlotRegexp.MatchString(s) {
+				errs = append(errs, fmt.Errorf("%q: %q must be a PCI address in the \"0000:05:00.1\" format, got: %v", k, key, value))
+			}
+		case "pci_vendor_info":
+			s, ok := value.(string)
+			if !ok || !networkingPortV2BindingProfileVendorInfoRegexp.MatchString(s) {
+				errs = append(errs, fmt.Errorf("%q: %q must be a PCI vendor and product ID in the \"8086:10ed\" format, got: %v", k, key, value))
+			}
+		case "physical_network", "card_serial_number", "pf_mac_address":
+			if _, ok := value.(string); !ok {
+				errs = append(errs, fmt.Errorf("%q: %q must be a string, got: %v", k, key, value))
+			}
+		case "vf_num":
+			if n, ok := value.(float64); !ok || n < 0 || n != float64(int(n)) {
+				errs = append(errs, fmt.Errorf("%q: %q must be a non-negative integer, got: %v", k, key, value))
+			}
+		case "trusted":
+			if _, ok := value.(bool); !ok {
+				errs = append(errs, fmt.Errorf("%q: %q must be a boolean, got: %v", k, key, value))
+			}
+		case "capabilities":
+			list, ok := value.([]interface{})
+			if !ok {
+				errs = append(errs, fmt.Errorf("%q: %q must be a list of strings, got: %v", k, key, value))
+				continue
+			}
+			for _, c := range list {
+				if _, ok := c.(string); !ok {
+					errs = append(errs, fmt.Errorf("%q: %q must be a list of strings, got: %v", k, key, value))
+					break
+				}
+			}
+		case "local_link_information":
+			list, ok := value.([]interface{})
+			if !ok {
+				errs = append(errs, fmt.Errorf("%q: %q must be a list of objects, got: %v", k, key, value))
+				continue
+			}
+			for _, l := range list {
+				if _, ok := l.(map[string]interface{}); !ok {
+					errs = append(errs, fmt.Errorf("%q: %q must be a list of objects, got: %v", k, key, value))
+					break
+				}
+			}
+		}
+	}
+
+	return nil, errs
+}
+
+// diffSuppressNetworkingPortV2BindingProfile compares binding profiles as
+// JSON objects instead of strings. Keys, which are only present in the
+// current profile and are filled in by Nova during the port binding, are
+// ignored, so that binding an SR-IOV port doesn't result in a diff.
+func diffSuppressNetworkingPortV2BindingProfile(k, old, new string, d *schema.ResourceData) bool {
+	if diffSuppressJSONObject(k, old, new, d) {
+		return true
+	}
+
+	var oldProfile, newProfile map[string]interface{}
+	if err := json.Unmarshal([]byte(old), &oldProfile); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newProfile); err != nil {
+		return false
+	}
+
+	for key, newValue := range newProfile {
+		oldValue, ok := oldProfile[key]
+		if !ok || !reflect.DeepEqual(oldValue, newValue) {
+			return false
+		}
+	}
+
+	for key := range oldProfile {
+		if _, ok := newProfile[key]; ok {
+			continue
+		}
+		if !strSliceContains(networkingPortV2BindingProfileComputedKeys, key) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -273,3 +273,60 @@ func TestUnitExpandNetworkingPortFixedIPV2SomeFixedIPs(t *testing.T) {
 
 	assert.ElementsMatch(t, expectedFixedIP, actualFixedIP)
 }
+
+func TestUnitValidateNetworkingPortV2BindingProfile(t *testing.T) {
+	valid := []string{
+		`{}`,
+		`{"capabilities": ["switchdev"]}`,
+		`{"pci_slot": "0000:05:00.1", "pci_vendor_info": "8086:10ed", "physical_network": "physnet1"}`,
+		`{"trusted": true, "vf_num": 3}`,
+		`{"local_link_information": [{"switch_id": "aa:bb:cc:dd:ee:ff", "port_id": "Ethernet3/1"}]}`,
+		`{"custom_key": {"nested": 1}}`,
+	}
+
+	for _, v := range valid {
+		_, errs := validateNetworkingPortV2BindingProfile(v, "profile")
+		assert.Empty(t, errs, v)
+	}
+
+	invalid := []string{
+		``,
+		`[]`,
+		`{"capabilities": "switchdev"}`,
+		`{"capabilities": [1]}`,
+		`{"pci_slot": "05:00.1"}`,
+		`{"pci_vendor_info": "intel"}`,
+		`{"trusted": "yes"}`,
+		`{"vf_num": 1.5}`,
+		`{"local_link_information": ["Ethernet3/1"]}`,
+	}
+
+	for _, v := range invalid {
+		_, errs := validateNetworkingPortV2BindingProfile(v, "profile")
+		assert.NotEmpty(t, errs, v)
+	}
+}
+
+func TestUnitDiffSuppressNetworkingPortV2BindingProfile(t *testing.T) {
+	suppressed := [][2]string{
+		{``, `{}`},
+		{`{"capabilities":["switchdev"]}`, `{"capabilities": ["switchdev"]}`},
+		{`{"capabilities":["switchdev"],"pci_slot":"0000:05:00.1","pci_vendor_info":"8086:10ed","physical_network":"physnet1"}`, `{"capabilities":["switchdev"]}`},
+		{`{"pci_slot":"0000:05:00.1"}`, `{}`},
+	}
+
+	for _, v := range suppressed {
+		assert.True(t, diffSuppressNetworkingPortV2BindingProfile("binding.0.profile", v[0], v[1], nil), v)
+	}
+
+	notSuppressed := [][2]string{
+		{`{"capabilities":["switchdev"]}`, `{"capabilities":[]}`},
+		{`{"capabilities":["switchdev"],"trusted":true}`, `{"capabilities":["switchdev"]}`},
+		{`{"pci_slot":"0000:05:00.1"}`, `{"pci_slot":"0000:05:00.2"}`},
+		{`{}`, `{"trusted":true}`},
+	}
+
+	for _, v := range notSuppressed {
+		assert.False(t, diffSuppressNetworkingPortV2BindingProfile("binding.0.profile", v[0], v[1], nil), v)
+	}
+}
@@ -2,6 +2,8 @@ package openstack
 
 import (
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -76,3 +78,228 @@ func networkingQoSMinimumBandwidthRuleV2StateRefreshFunc(client *gophercloud.Ser
 		return policy, "ACTIVE", nil
 	}
 }
+
+func networkingQoSPacketRateLimitRuleV2StateRefreshFunc(client *gophercloud.ServiceClient, policyID, ruleID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		policy, err := networkingQoSPacketRateLimitRuleV2Get(client, policyID, ruleID)
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return policy, "DELETED", nil
+			}
+			if _, ok := err.(gophercloud.ErrDefault409); ok {
+				return policy, "ACTIVE", nil
+			}
+
+			return nil, "", err
+		}
+
+		return policy, "ACTIVE", nil
+	}
+}
+
+func networkingQoSMinimumPacketRateRuleV2StateRefreshFunc(client *gophercloud.ServiceClient, policyID, ruleID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		policy, err := networkingQoSMinimumPacketRateRuleV2Get(client, policyID, ruleID)
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return policy, "DELETED", nil
+			}
+			if _, ok := err.(gophercloud.ErrDefault409); ok {
+				return policy, "ACTIVE", nil
+			}
+
+			return nil, "", err
+		}
+
+		return policy, "ACTIVE", nil
+	}
+}
+
+// networkingQoSPacketRateLimitRuleV2 represents a QoS packet rate limit rule.
+type networkingQoSPacketRateLimitRuleV2 struct {
+	ID           string `json:"id"`
+	MaxKPps      int    `json:"max_kpps"`
+	MaxBurstKPps int    `json:"max_burst_kpps"`
+	Direction    string `json:"direction"`
+}
+
+// networkingQoSPacketRateLimitRuleV2CreateOpts represents the attributes
+// used when creating a new QoS packet rate limit rule.
+type networkingQoSPacketRateLimitRuleV2CreateOpts struct {
+	MaxKPps      int    `json:"max_kpps"`
+	MaxBurstKPps int    `json:"max_burst_kpps,omitempty"`
+	Direction    string `json:"direction,omitempty"`
+}
+
+// networkingQoSPacketRateLimitRuleV2UpdateOpts represents the attributes
+// used when updating an existing QoS packet rate limit rule.
+type networkingQoSPacketRateLimitRuleV2UpdateOpts struct {
+	MaxKPps      *int   `json:"max_kpps,omitempty"`
+	MaxBurstKPps *int   `json:"max_burst_kpps,omitempty"`
+	Direction    string `json:"direction,omitempty"`
+}
+
+// networkingQoSMinimumPacketRateRuleV2 represents a QoS minimum packet rate
+// rule.
+type networkingQoSMinimumPacketRateRuleV2 struct {
+	ID        string `json:"id"`
+	MinKPps   int    `json:"min_kpps"`
+	Direction string `json:"direction"`
+}
+
+// networkingQoSMinimumPacketRateRuleV2CreateOpts represents the attributes
+// used when creating a new QoS minimum packet rate rule.
+type networkingQoSMinimumPacketRateRuleV2CreateOpts struct {
+	MinKPps   int    `json:"min_kpps"`
+	Direction string `json:"direction,omitempty"`
+}
+
+// networkingQoSMinimumPacketRateRuleV2UpdateOpts represents the attributes
+// used when updating an existing QoS minimum packet rate rule.
+type networkingQoSMinimumPacketRateRuleV2UpdateOpts struct {
+	MinKPps   *int   `json:"min_kpps,omitempty"`
+	Direction string `json:"direction,omitempty"`
+}
+
+func networkingQoSPacketRateLimitRuleV2Create(client *gophercloud.ServiceClient, policyID string, opts networkingQoSPacketRateLimitRuleV2CreateOpts) (*networkingQoSPacketRateLimitRuleV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "packet_rate_limit_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Rule *networkingQoSPacketRateLimitRuleV2 `json:"packet_rate_limit_rule"`
+	}
+	_, err = client.Post(client.ServiceURL("qos", "policies", policyID, "packet_rate_limit_rules"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+
+	return res.Rule, err
+}
+
+func networkingQoSPacketRateLimitRuleV2Get(client *gophercloud.ServiceClient, policyID, ruleID string) (*networkingQoSPacketRateLimitRuleV2, error) {
+	var res struct {
+		Rule *networkingQoSPacketRateLimitRuleV2 `json:"packet_rate_limit_rule"`
+	}
+	_, err := client.Get(client.ServiceURL("qos", "policies", policyID, "packet_rate_limit_rules", ruleID), &res, nil)
+
+	return res.Rule, err
+}
+
+// networkingQoSPacketRateLimitRuleV2List lists the packet rate limit rules of
+// a QoS policy, filtered by the non-zero attributes of filter.
+func networkingQoSPacketRateLimitRuleV2List(client *gophercloud.ServiceClient, policyID string, filter networkingQoSPacketRateLimitRuleV2) ([]networkingQoSPacketRateLimitRuleV2, error) {
+	q := url.Values{}
+	if filter.MaxKPps != 0 {
+		q.Set("max_kpps", strconv.Itoa(filter.MaxKPps))
+	}
+	if filter.MaxBurstKPps != 0 {
+		q.Set("max_burst_kpps", strconv.Itoa(filter.MaxBurstKPps))
+	}
+	if filter.Direction != "" {
+		q.Set("direction", filter.Direction)
+	}
+
+	u := client.ServiceURL("qos", "policies", policyID, "packet_rate_limit_rules")
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+
+	var res struct {
+		Rules []networkingQoSPacketRateLimitRuleV2 `json:"packet_rate_limit_rules"`
+	}
+	_, err := client.Get(u, &res, nil)
+
+	return res.Rules, err
+}
+
+func networkingQoSPacketRateLimitRuleV2Update(client *gophercloud.ServiceClient, policyID, ruleID string, opts networkingQoSPacketRateLimitRuleV2UpdateOpts) (*networkingQoSPacketRateLimitRuleV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "packet_rate_limit_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Rule *networkingQoSPacketRateLimitRuleV2 `json:"packet_rate_limit_rule"`
+	}
+	_, err = client.Put(client.ServiceURL("qos", "policies", policyID, "packet_rate_limit_rules", ruleID), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return res.Rule, err
+}
+
+func networkingQoSPacketRateLimitRuleV2Delete(client *gophercloud.ServiceClient, policyID, ruleID string) error {
+	_, err := client.Delete(client.ServiceURL("qos", "policies", policyID, "packet_rate_limit_rules", ruleID), nil)
+	return err
+}
+
+func networkingQoSMinimumPacketRateRuleV2Create(client *gophercloud.ServiceClient, policyID string, opts networkingQoSMinimumPacketRateRuleV2CreateOpts) (*networkingQoSMinimumPacketRateRuleV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "minimum_packet_rate_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Rule *networkingQoSMinimumPacketRateRuleV2 `json:"minimum_packet_rate_rule"`
+	}
+	_, err = client.Post(client.ServiceURL("qos", "policies", policyID, "minimum_packet_rate_rules"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+
+	return res.Rule, err
+}
+
+func networkingQoSMinimumPacketRateRuleV2Get(client *gophercloud.ServiceClient, policyID, ruleID string) (*networkingQoSMinimumPacketRateRuleV2, error) {
+	var res struct {
+		Rule *networkingQoSMinimumPacketRateRuleV2 `json:"minimum_packet_rate_rule"`
+	}
+	_, err := client.Get(client.ServiceURL("qos", "policies", policyID, "minimum_packet_rate_rules", ruleID), &res, nil)
+
+	return res.Rule, err
+}
+
+// networkingQoSMinimumPacketRateRuleV2List lists the minimum packet rate
+// rules of a QoS policy, filtered by the non-zero attributes of filter.
+func networkingQoSMinimumPacketRateRuleV2List(client *gophercloud.ServiceClient, policyID string, filter networkingQoSMinimumPacketRateRuleV2) ([]networkingQoSMinimumPacketRateRuleV2, error) {
+	q := url.Values{}
+	if filter.MinKPps != 0 {
+		q.Set("min_kpps", strconv.Itoa(filter.MinKPps))
+	}
+	if filter.Direction != "" {
+		q.Set("direction", filter.Direction)
+	}
+
+	u := client.ServiceURL("qos", "policies", policyID, "minimum_packet_rate_rules")
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+
+	var res struct {
+		Rules []networkingQoSMinimumPacketRateRuleV2 `json:"minimum_packet_rate_rules"`
+	}
+	_, err := client.Get(u, &res, nil)
+
+	return res.Rules, err
+}
+
+func networkingQoSMinimumPacketRateRuleV2Update(client *gophercloud.ServiceClient, policyID, ruleID string, opts networkingQoSMinimumPacketRateRuleV2UpdateOpts) (*networkingQoSMinimumPacketRateRuleV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "minimum_packet_rate_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Rule *networkingQoSMinimumPacketRateRuleV2 `json:"minimum_packet_rate_rule"`
+	}
+	_, err = client.Put(client.ServiceURL("qos", "policies", policyID, "minimum_packet_rate_rules", ruleID), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return res.Rule, err
+}
+
+func networkingQoSMinimumPacketRateRuleV2Delete(client *gophercloud.ServiceClient, policyID, ruleID string) error {
+	_, err := client.Delete(client.ServiceURL("qos", "policies", policyID, "minimum_packet_rate_rules", ruleID), nil)
+	return err
+}
@@ -0,0 +1,95 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// routerConntrackHelperV2 represents a Neutron router conntrack helper.
+type routerConntrackHelperV2 struct {
+	ID       string `json:"id"`
+	Protocol string `json:"protocol"`
+	Port     int    `json:"port"`
+	Helper   string `json:"helper"`
+}
+
+// routerConntrackHelperV2CreateOpts represents the attributes used when
+// creating a new router conntrack helper.
+type routerConntrackHelperV2CreateOpts struct {
+	Protocol string `json:"protocol" required:"true"`
+	Port     int    `json:"port" required:"true"`
+	Helper   string `json:"helper" required:"true"`
+}
+
+// routerConntrackHelperV2UpdateOpts represents the attributes used when
+// updating an existing router conntrack helper.
+type routerConntrackHelperV2UpdateOpts struct {
+	Protocol string `json:"protocol,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	Helper   string `json:"helper,omitempty"`
+}
+
+func routerConntrackHelperV2URL(client *gophercloud.ServiceClient, routerID string, parts ...string) string {
+	return client.ServiceURL(append([]string{"routers", routerID, "conntrack_helpers"}, parts...)...)
+}
+
+func routerConntrackHelperV2Create(client *gophercloud.ServiceClient, routerID string, opts routerConntrackHelperV2CreateOpts) (*routerConntrackHelperV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "conntrack_helper")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		ConntrackHelper *routerConntrackHelperV2 `json:"conntrack_helper"`
+	}
+	_, err = client.Post(routerConntrackHelperV2URL(client, routerID), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+
+	return res.ConntrackHelper, err
+}
+
+func routerConntrackHelperV2Get(client *gophercloud.ServiceClient, routerID, id string) (*routerConntrackHelperV2, error) {
+	var res struct {
+		ConntrackHelper *routerConntrackHelperV2 `json:"conntrack_helper"`
+	}
+	_, err := client.Get(routerConntrackHelperV2URL(client, routerID, id), &res, nil)
+
+	return res.ConntrackHelper, err
+}
+
+func routerConntrackHelperV2Update(client *gophercloud.ServiceClient, routerID, id string, opts routerConntrackHelperV2UpdateOpts) (*routerConntrackHelperV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "conntrack_helper")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		ConntrackHelper *routerConntrackHelperV2 `json:"conntrack_helper"`
+	}
+	_, err = client.Put(routerConntrackHelperV2URL(client, routerID, id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return res.ConntrackHelper, err
+}
+
+func routerConntrackHelperV2Delete(client *gophercloud.ServiceClient, routerID, id string) error {
+	_, err := client.Delete(routerConntrackHelperV2URL(client, routerID, id), nil)
+	return err
+}
+
+func resourceNetworkingRouterConntrackHelperV2BuildID(routerID, helperID string) string {
+	return fmt.Sprintf("%s/%s", routerID, helperID)
+}
+
+func resourceNetworkingRouterConntrackHelperV2ParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format: %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,22 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceNetworkingRouterConntrackHelperV2ParseID(t *testing.T) {
+	routerID, helperID, err := resourceNetworkingRouterConntrackHelperV2ParseID("router/helper")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "router", routerID)
+	assert.Equal(t, "helper", helperID)
+}
+
+func TestUnitResourceNetworkingRouterConntrackHelperV2ParseIDInvalid(t *testing.T) {
+	for _, id := range []string{"helper", "router/", "/helper", "router/helper/extra"} {
+		_, _, err := resourceNetworkingRouterConntrackHelperV2ParseID(id)
+		assert.Error(t, err, id)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/extraroutes"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
@@ -64,36 +65,11 @@ func resourceNetworkingRouterInterfaceV2DeleteRefreshFunc(networkingClient *goph
 			if _, ok := err.(gophercloud.ErrDefault409); ok {
 				if ok && d.Get("force_destroy").(bool) {
 					// The router may have routes preventing the interface to be deleted.
-					// Check which routes correspond to a particular router interface.
-					var updateRoutes []routers.Route
-					if removeOpts.SubnetID != "" {
-						// get subnet CIDR
-						subnet, err := subnets.Get(networkingClient, removeOpts.SubnetID).Extract()
-						if err != nil {
-							return r, "ACTIVE", err
-						}
-						_, cidr, err := net.ParseCIDR(subnet.CIDR)
-						if err != nil {
-							return r, "ACTIVE", err
-						}
-						// determine which routes must be removed
-						router, err := routers.Get(networkingClient, routerID).Extract()
-						if err != nil {
-							return r, "ACTIVE", err
-						}
-						for _, route := range router.Routes {
-							if ip := net.ParseIP(route.NextHop); ip != nil && !cidr.Contains(ip) {
-								updateRoutes = append(updateRoutes, route)
-							}
-						}
-					}
-
+					// Only remove the routes whose next hop is inside the subnets of
+					// this interface, so that concurrently added routes are kept.
 					log.Printf("[DEBUG] Attempting to forceDestroy openstack_networking_router_interface_v2 '%s': %+v", d.Id(), err)
 
-					opts := &routers.UpdateOpts{
-						Routes: &updateRoutes,
-					}
-					_, err := routers.Update(networkingClient, routerID, opts).Extract()
+					err := networkingRouterInterfaceV2RemoveRoutes(networkingClient, routerID, removeOpts.SubnetID, r)
 					if err != nil {
 						return r, "ACTIVE", err
 					}
@@ -110,3 +86,68 @@ func resourceNetworkingRouterInterfaceV2DeleteRefreshFunc(networkingClient *goph
 		return r, "ACTIVE", nil
 	}
 }
+
+// networkingRouterInterfaceV2RemoveRoutes removes the routes of a router whose
+// next hop is inside the subnet of a router interface. When subnetID is empty,
+// the subnets are taken from the fixed IPs of the interface port.
+func networkingRouterInterfaceV2RemoveRoutes(networkingClient *gophercloud.ServiceClient, routerID, subnetID string, port *ports.Port) error {
+	subnetIDs := []string{subnetID}
+	if subnetID == "" {
+		subnetIDs = nil
+		for _, fixedIP := range port.FixedIPs {
+			subnetIDs = append(subnetIDs, fixedIP.SubnetID)
+		}
+	}
+
+	var cidrs []*net.IPNet
+	for _, id := range subnetIDs {
+		subnet, err := subnets.Get(networkingClient, id).Extract()
+		if err != nil {
+			return err
+		}
+		_, cidr, err := net.ParseCIDR(subnet.CIDR)
+		if err != nil {
+			return err
+		}
+		cidrs = append(cidrs, cidr)
+	}
+
+	router, err := routers.Get(networkingClient, routerID).Extract()
+	if err != nil {
+		return err
+	}
+
+	routes := networkingRouterInterfaceV2RoutesInCIDRs(router.Routes, cidrs)
+	if len(routes) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Removing routes %+v from openstack_networking_router_v2 %s", routes, routerID)
+
+	removeOpts := extraroutes.Opts{
+		Routes: &routes,
+	}
+	_, err = extraroutes.Remove(networkingClient, routerID, removeOpts).Extract()
+
+	return err
+}
+
+// networkingRouterInterfaceV2RoutesInCIDRs returns the routes whose next hop
+// is inside one of the given CIDRs.
+func networkingRouterInterfaceV2RoutesInCIDRs(routes []routers.Route, cidrs []*net.IPNet) []routers.Route {
+	var res []routers.Route
+	for _, route := range routes {
+		ip := net.ParseIP(route.NextHop)
+		if ip == nil {
+			continue
+		}
+		for _, cidr := range cidrs {
+			if cidr.Contains(ip) {
+				res = append(res, route)
+				break
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,50 @@
+package openstack
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+)
+
+func TestUnitNetworkingRouterInterfaceV2RoutesInCIDRs(t *testing.T) {
+	_, cidr1, _ := net.ParseCIDR("192.168.199.0/24")
+	_, cidr2, _ := net.ParseCIDR("fd00::/64")
+
+	routes := []routers.Route{
+		{
+			DestinationCIDR: "10.0.1.0/24",
+			NextHop:         "192.168.199.254",
+		},
+		{
+			DestinationCIDR: "10.0.2.0/24",
+			NextHop:         "192.168.200.254",
+		},
+		{
+			DestinationCIDR: "fd01::/64",
+			NextHop:         "fd00::10",
+		},
+		{
+			DestinationCIDR: "10.0.3.0/24",
+			NextHop:         "invalid",
+		},
+	}
+
+	expected := []routers.Route{
+		{
+			DestinationCIDR: "10.0.1.0/24",
+			NextHop:         "192.168.199.254",
+		},
+		{
+			DestinationCIDR: "fd01::/64",
+			NextHop:         "fd00::10",
+		},
+	}
+
+	actual := networkingRouterInterfaceV2RoutesInCIDRs(routes, []*net.IPNet{cidr1, cidr2})
+	assert.Equal(t, expected, actual)
+
+	assert.Empty(t, networkingRouterInterfaceV2RoutesInCIDRs(routes, nil))
+}
@@ -1,12 +1,18 @@
 package openstack
 
 import (
+	"bytes"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/utils/terraform/hashcode"
 )
 
 // networkingSecgroupV2StateRefreshFuncDelete returns a special case resource.StateRefreshFunc to try to delete a secgroup.
@@ -42,3 +48,190 @@ func networkingSecgroupV2StateRefreshFuncDelete(networkingClient *gophercloud.Se
 		return r, "ACTIVE", nil
 	}
 }
+
+func networkingSecgroupV2RuleHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["direction"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["ethertype"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["protocol"].(string))))
+	buf.WriteString(fmt.Sprintf("%d-", m["port_range_min"].(int)))
+	buf.WriteString(fmt.Sprintf("%d-", m["port_range_max"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["remote_ip_prefix"].(string))))
+	buf.WriteString(fmt.Sprintf("%s-", m["remote_group_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%t-", m["self"].(bool)))
+	buf.WriteString(fmt.Sprintf("%s-", m["description"].(string)))
+
+	return hashcode.String(buf.String())
+}
+
+func expandNetworkingSecgroupV2Rules(sgID string, rawRules []interface{}) ([]rules.CreateOpts, error) {
+	createOpts := make([]rules.CreateOpts, len(rawRules))
+
+	for i, rawRule := range rawRules {
+		opts, err := expandNetworkingSecgroupV2Rule(sgID, rawRule)
+		if err != nil {
+			return nil, err
+		}
+		createOpts[i] = opts
+	}
+
+	return createOpts, nil
+}
+
+func expandNetworkingSecgroupV2Rule(sgID string, rawRule interface{}) (rules.CreateOpts, error) {
+	rawRuleMap := rawRule.(map[string]interface{})
+
+	remoteGroupID := rawRuleMap["remote_group_id"].(string)
+	remoteIPPrefix := rawRuleMap["remote_ip_prefix"].(string)
+	self := rawRuleMap["self"].(bool)
+
+	if (remoteGroupID != "" && remoteIPPrefix != "") || (self && (remoteGroupID != "" || remoteIPPrefix != "")) {
+		return rules.CreateOpts{}, fmt.Errorf("Only one of remote_group_id, remote_ip_prefix, or self can be set in an openstack_networking_secgroup_v2 rule")
+	}
+
+	if self {
+		remoteGroupID = sgID
+	}
+
+	portRangeMin := rawRuleMap["port_range_min"].(int)
+	portRangeMax := rawRuleMap["port_range_max"].(int)
+	rawProtocol := rawRuleMap["protocol"].(string)
+
+	if rawProtocol == "" && (portRangeMin != 0 || portRangeMax != 0) {
+		return rules.CreateOpts{}, fmt.Errorf("A protocol must be specified when using port_range_min and port_range_max in an openstack_networking_secgroup_v2 rule")
+	}
+
+	direction, err := resourceNetworkingSecGroupRuleV2Direction(rawRuleMap["direction"].(string))
+	if err != nil {
+		return rules.CreateOpts{}, err
+	}
+
+	ethertype, err := resourceNetworkingSecGroupRuleV2EtherType(rawRuleMap["ethertype"].(string))
+	if err != nil {
+		return rules.CreateOpts{}, err
+	}
+
+	opts := rules.CreateOpts{
+		Direction:      direction,
+		EtherType:      ethertype,
+		Description:    rawRuleMap["description"].(string),
+		SecGroupID:     sgID,
+		PortRangeMin:   portRangeMin,
+		PortRangeMax:   portRangeMax,
+		RemoteGroupID:  remoteGroupID,
+		RemoteIPPrefix: remoteIPPrefix,
+	}
+
+	if rawProtocol != "" {
+		protocol, err := resourceNetworkingSecGroupRuleV2Protocol(rawProtocol)
+		if err != nil {
+			return rules.CreateOpts{}, err
+		}
+		opts.Protocol = protocol
+	}
+
+	return opts, nil
+}
+
+func flattenNetworkingSecgroupV2Rules(sgID string, sgRules []rules.SecGroupRule) []interface{} {
+	sgrList := make([]interface{}, len(sgRules))
+
+	for i, sgr := range sgRules {
+		remoteGroupID := sgr.RemoteGroupID
+		self := false
+		if remoteGroupID == sgID {
+			remoteGroupID = ""
+			self = true
+		}
+
+		sgrList[i] = map[string]interface{}{
+			"id":               sgr.ID,
+			"direction":        sgr.Direction,
+			"ethertype":        sgr.EtherType,
+			"protocol":         sgr.Protocol,
+			"port_range_min":   sgr.PortRangeMin,
+			"port_range_max":   sgr.PortRangeMax,
+			"remote_ip_prefix": strings.ToLower(sgr.RemoteIPPrefix),
+			"remote_group_id":  remoteGroupID,
+			"self":             self,
+			"description":      sgr.Description,
+		}
+	}
+
+	return sgrList
+}
+
+// networkingSecgroupV2RulesCreateBulk creates several security group rules
+// with a single Neutron bulk create request.
+func networkingSecgroupV2RulesCreateBulk(client *gophercloud.ServiceClient, opts []rules.CreateOpts) ([]rules.SecGroupRule, error) {
+	rawRules := make([]map[string]interface{}, len(opts))
+	for i, o := range opts {
+		b, err := o.ToSecGroupRuleCreateMap()
+		if err != nil {
+			return nil, err
+		}
+		rawRules[i] = b["security_group_rule"].(map[string]interface{})
+	}
+
+	reqBody := map[string]interface{}{
+		"security_group_rules": rawRules,
+	}
+
+	var resBody struct {
+		Rules []rules.SecGroupRule `json:"security_group_rules"`
+	}
+
+	_, err := client.Post(client.ServiceURL("security-group-rules"), reqBody, &resBody, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resBody.Rules, nil
+}
+
+// networkingSecgroupV2UpdateRules converges the rules of a security group
+// from oldRules to newRules. Rules which are only present in oldRules are
+// deleted and rules which are only present in newRules are created in bulk.
+func networkingSecgroupV2UpdateRules(client *gophercloud.ServiceClient, sgID string, oldRules, newRules *schema.Set) error {
+	rulesToRemove := oldRules.Difference(newRules)
+	rulesToAdd := newRules.Difference(oldRules)
+
+	log.Printf("[DEBUG] openstack_networking_secgroup_v2 %s rules to add: %v", sgID, rulesToAdd)
+	log.Printf("[DEBUG] openstack_networking_secgroup_v2 %s rules to remove: %v", sgID, rulesToRemove)
+
+	// Remove rules first, so that a rule, which only differs by its
+	// description, doesn't conflict with its replacement.
+	for _, rawRule := range rulesToRemove.List() {
+		ruleID := rawRule.(map[string]interface{})["id"].(string)
+		if ruleID == "" {
+			continue
+		}
+
+		if err := rules.Delete(client, ruleID).ExtractErr(); err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				continue
+			}
+
+			return fmt.Errorf("Error removing rule %s from openstack_networking_secgroup_v2 %s: %s", ruleID, sgID, err)
+		}
+	}
+
+	if rulesToAdd.Len() == 0 {
+		return nil
+	}
+
+	createOpts, err := expandNetworkingSecgroupV2Rules(sgID, rulesToAdd.List())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] openstack_networking_secgroup_v2 %s bulk rule create options: %#v", sgID, createOpts)
+	if _, err := networkingSecgroupV2RulesCreateBulk(client, createOpts); err != nil {
+		return fmt.Errorf("Error adding rules to openstack_networking_secgroup_v2 %s: %s", sgID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+)
+
+func TestUnitExpandNetworkingSecgroupV2Rule(t *testing.T) {
+	rawRule := map[string]interface{}{
+		"direction":        "ingress",
+		"ethertype":        "IPv4",
+		"protocol":         "tcp",
+		"port_range_min":   22,
+		"port_range_max":   22,
+		"remote_ip_prefix": "0.0.0.0/0",
+		"remote_group_id":  "",
+		"self":             false,
+		"description":      "ssh",
+	}
+
+	expected := rules.CreateOpts{
+		Direction:      rules.DirIngress,
+		EtherType:      rules.EtherType4,
+		Protocol:       rules.ProtocolTCP,
+		Description:    "ssh",
+		SecGroupID:     "sg",
+		PortRangeMin:   22,
+		PortRangeMax:   22,
+		RemoteIPPrefix: "0.0.0.0/0",
+	}
+
+	actual, err := expandNetworkingSecgroupV2Rule("sg", rawRule)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitExpandNetworkingSecgroupV2RuleSelf(t *testing.T) {
+	rawRule := map[string]interface{}{
+		"direction":        "ingress",
+		"ethertype":        "IPv6",
+		"protocol":         "",
+		"port_range_min":   0,
+		"port_range_max":   0,
+		"remote_ip_prefix": "",
+		"remote_group_id":  "",
+		"self":             true,
+		"description":      "",
+	}
+
+	expected := rules.CreateOpts{
+		Direction:     rules.DirIngress,
+		EtherType:     rules.EtherType6,
+		SecGroupID:    "sg",
+		RemoteGroupID: "sg",
+	}
+
+	actual, err := expandNetworkingSecgroupV2Rule("sg", rawRule)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitExpandNetworkingSecgroupV2RuleConflictingRemotes(t *testing.T) {
+	rawRule := map[string]interface{}{
+		"direction":        "ingress",
+		"ethertype":        "IPv4",
+		"protocol":         "",
+		"port_range_min":   0,
+		"port_range_max":   0,
+		"remote_ip_prefix": "10.0.0.0/8",
+		"remote_group_id":  "",
+		"self":             true,
+		"description":      "",
+	}
+
+	_, err := expandNetworkingSecgroupV2Rule("sg", rawRule)
+
+	assert.Error(t, err)
+}
+
+func TestUnitExpandNetworkingSecgroupV2RulePortsWithoutProtocol(t *testing.T) {
+	rawRule := map[string]interface{}{
+		"direction":        "ingress",
+		"ethertype":        "IPv4",
+		"protocol":         "",
+		"port_range_min":   80,
+		"port_range_max":   80,
+		"remote_ip_prefix": "",
+		"remote_group_id":  "",
+		"self":             false,
+		"description":      "",
+	}
+
+	_, err := expandNetworkingSecgroupV2Rule("sg", rawRule)
+
+	assert.Error(t, err)
+}
+
+func TestUnitFlattenNetworkingSecgroupV2Rules(t *testing.T) {
+	sgRules := []rules.SecGroupRule{
+		{
+			ID:             "rule_1",
+			Direction:      "ingress",
+			EtherType:      "IPv6",
+			Protocol:       "tcp",
+			PortRangeMin:   443,
+			PortRangeMax:   443,
+			RemoteIPPrefix: "2001:DB8::/32",
+			SecGroupID:     "sg",
+		},
+		{
+			ID:            "rule_2",
+			Direction:     "ingress",
+			EtherType:     "IPv4",
+			RemoteGroupID: "sg",
+			SecGroupID:    "sg",
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"id":               "rule_1",
+			"direction":        "ingress",
+			"ethertype":        "IPv6",
+			"protocol":         "tcp",
+			"port_range_min":   443,
+			"port_range_max":   443,
+			"remote_ip_prefix": "2001:db8::/32",
+			"remote_group_id":  "",
+			"self":             false,
+			"description":      "",
+		},
+		map[string]interface{}{
+			"id":               "rule_2",
+			"direction":        "ingress",
+			"ethertype":        "IPv4",
+			"protocol":         "",
+			"port_range_min":   0,
+			"port_range_max":   0,
+			"remote_ip_prefix": "",
+			"remote_group_id":  "",
+			"self":             true,
+			"description":      "",
+		},
+	}
+
+	actual := flattenNetworkingSecgroupV2Rules("sg", sgRules)
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestUnitNetworkingSecgroupV2RuleHashIgnoresID(t *testing.T) {
+	rule1 := map[string]interface{}{
+		"id":               "rule_1",
+		"direction":        "egress",
+		"ethertype":        "IPv4",
+		"protocol":         "udp",
+		"port_range_min":   53,
+		"port_range_max":   53,
+		"remote_ip_prefix": "10.0.0.0/8",
+		"remote_group_id":  "",
+		"self":             false,
+		"description":      "",
+	}
+
+	rule2 := map[string]interface{}{
+		"id":               "",
+		"direction":        "egress",
+		"ethertype":        "IPv4",
+		"protocol":         "udp",
+		"port_range_min":   53,
+		"port_range_max":   53,
+		"remote_ip_prefix": "10.0.0.0/8",
+		"remote_group_id":  "",
+		"self":             false,
+		"description":      "",
+	}
+
+	oldRules := schema.NewSet(networkingSecgroupV2RuleHash, []interface{}{rule1})
+	newRules := schema.NewSet(networkingSecgroupV2RuleHash, []interface{}{rule2})
+
+	assert.Equal(t, 0, oldRules.Difference(newRules).Len())
+	assert.Equal(t, 0, newRules.Difference(oldRules).Len())
+}
@@ -0,0 +1,69 @@
+package openstack
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// objectStorageAccountV1ReservedMetadata are the account metadata keys, which
+// are managed by dedicated attributes or by the reseller admin and therefore
+// aren't part of the metadata attribute.
+var objectStorageAccountV1ReservedMetadata = []string{
+	"Temp-Url-Key",
+	"Temp-Url-Key-2",
+	"Quota-Bytes",
+}
+
+// objectStorageAccountV1Name returns the account name, e.g. AUTH_<project_id>,
+// which is the last path element of the object storage endpoint.
+func objectStorageAccountV1Name(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+
+	return path.Base(strings.TrimSuffix(u.Path, "/"))
+}
+
+// objectStorageAccountV1RemovedMetadata returns the metadata keys, which are
+// set in old, but not in new.
+func objectStorageAccountV1RemovedMetadata(old, new map[string]interface{}) []string {
+	newKeys := make(map[string]struct{}, len(new))
+	for k := range new {
+		newKeys[http.CanonicalHeaderKey(k)] = struct{}{}
+	}
+
+	var remove []string
+	for k := range old {
+		if _, ok := newKeys[http.CanonicalHeaderKey(k)]; !ok {
+			remove = append(remove, k)
+		}
+	}
+
+	return remove
+}
+
+// flattenObjectStorageAccountV1Metadata returns the account metadata without
+// the reserved keys. The keys of configured metadata retain their case, since
+// Swift returns them in canonical header format.
+func flattenObjectStorageAccountV1Metadata(metadata map[string]string, configured map[string]interface{}) map[string]string {
+	keys := make(map[string]string, len(configured))
+	for k := range configured {
+		keys[http.CanonicalHeaderKey(k)] = k
+	}
+
+	result := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		if strSliceContains(objectStorageAccountV1ReservedMetadata, http.CanonicalHeaderKey(k)) {
+			continue
+		}
+		if configuredKey, ok := keys[http.CanonicalHeaderKey(k)]; ok {
+			k = configuredKey
+		}
+		result[k] = v
+	}
+
+	return result
+}
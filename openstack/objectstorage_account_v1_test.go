@@ -0,0 +1,51 @@
+package openstack
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitObjectStorageAccountV1Name(t *testing.T) {
+	assert.Equal(t, "AUTH_abc123", objectStorageAccountV1Name("https://swift.example.com:8080/v1/AUTH_abc123/"))
+	assert.Equal(t, "AUTH_abc123", objectStorageAccountV1Name("https://swift.example.com/swift/v1/AUTH_abc123"))
+}
+
+func TestUnitObjectStorageAccountV1RemovedMetadata(t *testing.T) {
+	old := map[string]interface{}{
+		"test":      "true",
+		"upperTest": "true",
+		"other":     "true",
+	}
+	new := map[string]interface{}{
+		"Test": "false",
+	}
+
+	remove := objectStorageAccountV1RemovedMetadata(old, new)
+	sort.Strings(remove)
+
+	assert.Equal(t, []string{"other", "upperTest"}, remove)
+}
+
+func TestUnitFlattenObjectStorageAccountV1Metadata(t *testing.T) {
+	metadata := map[string]string{
+		"Test":         "true",
+		"Uppertest":    "true",
+		"Other":        "true",
+		"Temp-Url-Key": "secret",
+		"Quota-Bytes":  "1024",
+	}
+	configured := map[string]interface{}{
+		"test":      "true",
+		"upperTest": "true",
+	}
+
+	expected := map[string]string{
+		"test":      "true",
+		"upperTest": "true",
+		"Other":     "true",
+	}
+
+	assert.Equal(t, expected, flattenObjectStorageAccountV1Metadata(metadata, configured))
+}
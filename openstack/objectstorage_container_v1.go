@@ -0,0 +1,149 @@
+package openstack
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// objectStorageContainerV1MetadataFields maps the typed container attributes
+// to the container metadata keys used by the staticweb and container_quotas
+// Swift middlewares.
+var objectStorageContainerV1MetadataFields = []struct {
+	attr string
+	key  string
+}{
+	{"web_index", "Web-Index"},
+	{"web_error", "Web-Error"},
+	{"web_listings", "Web-Listings"},
+	{"web_listings_css", "Web-Listings-CSS"},
+	{"quota_bytes", "Quota-Bytes"},
+	{"quota_count", "Quota-Count"},
+}
+
+// objectStorageContainerV1MetadataHasKey returns true if the metadata key is
+// set in the generic metadata map, regardless of its case.
+func objectStorageContainerV1MetadataHasKey(metadata map[string]interface{}, key string) bool {
+	for k := range metadata {
+		if http.CanonicalHeaderKey(k) == http.CanonicalHeaderKey(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expandObjectStorageContainerV1TypedMetadata returns the metadata to set and
+// the metadata keys to remove for the typed container attributes. If
+// onlyChanged is true, unchanged attributes are skipped. Keys which are also
+// set in the generic metadata map are never removed.
+func expandObjectStorageContainerV1TypedMetadata(d *schema.ResourceData, onlyChanged bool) (map[string]string, []string) {
+	metadata := make(map[string]string)
+	genericMetadata := d.Get("metadata").(map[string]interface{})
+	var remove []string
+
+	for _, field := range objectStorageContainerV1MetadataFields {
+		if onlyChanged && !d.HasChange(field.attr) {
+			continue
+		}
+
+		var value string
+		switch v := d.Get(field.attr).(type) {
+		case string:
+			value = v
+		case bool:
+			if v {
+				value = "true"
+			}
+		case int:
+			if v > 0 {
+				value = strconv.Itoa(v)
+			}
+		}
+
+		if value == "" {
+			if !objectStorageContainerV1MetadataHasKey(genericMetadata, field.key) {
+				remove = append(remove, field.key)
+			}
+			continue
+		}
+		metadata[field.key] = value
+	}
+
+	return metadata, remove
+}
+
+// flattenObjectStorageContainerV1TypedMetadata sets the typed container
+// attributes from the container metadata.
+func flattenObjectStorageContainerV1TypedMetadata(d *schema.ResourceData, metadata map[string]string) {
+	for _, field := range objectStorageContainerV1MetadataFields {
+		value := metadata[http.CanonicalHeaderKey(field.key)]
+
+		switch d.Get(field.attr).(type) {
+		case string:
+			d.Set(field.attr, value)
+		case bool:
+			v, _ := strconv.ParseBool(value)
+			d.Set(field.attr, v)
+		case int:
+			v, _ := strconv.Atoi(value)
+			d.Set(field.attr, v)
+		}
+	}
+}
+
+// resourceObjectStorageContainerV1TypedMetadataDiff plans the removal of the
+// typed container attributes, which were removed from the configuration.
+// They are Optional+Computed, so that metadata set through the generic
+// metadata map doesn't show up as a diff. Keys which are set in the generic
+// metadata map are left untouched.
+func resourceObjectStorageContainerV1TypedMetadataDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	oldMetadata, newMetadata := d.GetChange("metadata")
+	for _, field := range objectStorageContainerV1MetadataFields {
+		if !rawConfig.GetAttr(field.attr).IsNull() {
+			continue
+		}
+
+		if objectStorageContainerV1MetadataHasKey(oldMetadata.(map[string]interface{}), field.key) ||
+			objectStorageContainerV1MetadataHasKey(newMetadata.(map[string]interface{}), field.key) {
+			continue
+		}
+
+		var zero interface{}
+		switch v := d.Get(field.attr).(type) {
+		case string:
+			if v != "" {
+				zero = ""
+			}
+		case bool:
+			if v {
+				zero = false
+			}
+		case int:
+			if v != 0 {
+				zero = 0
+			}
+		}
+
+		if zero == nil {
+			continue
+		}
+
+		if err := d.SetNew(field.attr, zero); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
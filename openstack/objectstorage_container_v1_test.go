@@ -0,0 +1,91 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitExpandObjectStorageContainerV1TypedMetadata(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":         "container_1",
+		"web_index":    "index.html",
+		"web_listings": true,
+		"quota_count":  100,
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceObjectStorageContainerV1().Schema, raw)
+
+	expectedMetadata := map[string]string{
+		"Web-Index":    "index.html",
+		"Web-Listings": "true",
+		"Quota-Count":  "100",
+	}
+	expectedRemove := []string{"Web-Error", "Web-Listings-CSS", "Quota-Bytes"}
+
+	metadata, remove := expandObjectStorageContainerV1TypedMetadata(d, false)
+
+	assert.Equal(t, expectedMetadata, metadata)
+	assert.Equal(t, expectedRemove, remove)
+}
+
+func TestUnitExpandObjectStorageContainerV1TypedMetadataGenericMetadata(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":      "container_1",
+		"web_index": "index.html",
+		"metadata": map[string]interface{}{
+			"web-listings": "true",
+			"Quota-Bytes":  "1048576",
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceObjectStorageContainerV1().Schema, raw)
+
+	expectedMetadata := map[string]string{
+		"Web-Index": "index.html",
+	}
+	expectedRemove := []string{"Web-Error", "Web-Listings-CSS", "Quota-Count"}
+
+	metadata, remove := expandObjectStorageContainerV1TypedMetadata(d, false)
+
+	assert.Equal(t, expectedMetadata, metadata)
+	assert.Equal(t, expectedRemove, remove)
+}
+
+func TestUnitObjectStorageContainerV1MetadataHasKey(t *testing.T) {
+	metadata := map[string]interface{}{
+		"web-index":   "index.html",
+		"Quota-Bytes": "1048576",
+	}
+
+	assert.True(t, objectStorageContainerV1MetadataHasKey(metadata, "Web-Index"))
+	assert.True(t, objectStorageContainerV1MetadataHasKey(metadata, "Quota-Bytes"))
+	assert.False(t, objectStorageContainerV1MetadataHasKey(metadata, "Quota-Count"))
+}
+
+func TestUnitFlattenObjectStorageContainerV1TypedMetadata(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":      "container_1",
+		"web_error": "error.html",
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceObjectStorageContainerV1().Schema, raw)
+
+	metadata := map[string]string{
+		"Web-Index":        "index.html",
+		"Web-Listings":     "true",
+		"Web-Listings-Css": "style.css",
+		"Quota-Bytes":      "1048576",
+	}
+
+	flattenObjectStorageContainerV1TypedMetadata(d, metadata)
+
+	assert.Equal(t, "index.html", d.Get("web_index"))
+	assert.Equal(t, "", d.Get("web_error"))
+	assert.Equal(t, true, d.Get("web_listings"))
+	assert.Equal(t, "style.css", d.Get("web_listings_css"))
+	assert.Equal(t, 1048576, d.Get("quota_bytes"))
+	assert.Equal(t, 0, d.Get("quota_count"))
+}
@@ -0,0 +1,280 @@
+package openstack
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+// objectStorageObjectSyncV1Match reports whether a relative file path is
+// selected by the include and exclude patterns. Patterns are matched against
+// both the relative path and the file name.
+func objectStorageObjectSyncV1Match(name string, include, exclude []string) (bool, error) {
+	match := func(patterns []string) (bool, error) {
+		for _, pattern := range patterns {
+			for _, v := range []string{name, path.Base(name)} {
+				ok, err := path.Match(pattern, v)
+				if err != nil {
+					return false, fmt.Errorf("Invalid pattern %q: %s", pattern, err)
+				}
+				if ok {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	}
+
+	if len(include) > 0 {
+		ok, err := match(include)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	ok, err := match(exclude)
+	if err != nil {
+		return false, err
+	}
+
+	return !ok, nil
+}
+
+// objectStorageObjectSyncV1LocalFiles walks the source directory and returns
+// the MD5 checksum of every selected file, keyed by its slash separated path
+// relative to the source directory.
+func objectStorageObjectSyncV1LocalFiles(sourceDir string, include, exclude []string) (map[string]string, error) {
+	dir, err := homedir.Expand(sourceDir)
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding homedir in source_dir (%s): %s", sourceDir, err)
+	}
+
+	files := make(map[string]string)
+	err = filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+
+		ok, err := objectStorageObjectSyncV1Match(name, include, exclude)
+		if err != nil || !ok {
+			return err
+		}
+
+		hash, err := objectStorageObjectSyncV1FileMD5(p)
+		if err != nil {
+			return err
+		}
+		files[name] = hash
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading source_dir (%s): %s", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+func objectStorageObjectSyncV1FileMD5(p string) (string, error) {
+	file, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
+// objectStorageObjectSyncV1RemoteFiles returns the MD5 checksum of every
+// selected object under the prefix, keyed by its name without the prefix.
+func objectStorageObjectSyncV1RemoteFiles(client *gophercloud.ServiceClient, containerName, prefix string, include, exclude []string) (map[string]string, error) {
+	listOpts := &objects.ListOpts{
+		Full:   true,
+		Prefix: prefix,
+	}
+
+	allPages, err := objects.List(client, containerName, listOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allObjects, err := objects.ExtractInfo(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]string, len(allObjects))
+	for _, object := range allObjects {
+		name := strings.TrimPrefix(object.Name, prefix)
+		if name == "" || object.Subdir != "" {
+			continue
+		}
+
+		ok, err := objectStorageObjectSyncV1Match(name, include, exclude)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			files[name] = object.Hash
+		}
+	}
+
+	return files, nil
+}
+
+// objectStorageObjectSyncV1Tracked returns the remote files, which are
+// tracked by the resource, i.e. which were previously synced by it.
+func objectStorageObjectSyncV1Tracked(remote map[string]string, tracked map[string]interface{}) map[string]string {
+	files := make(map[string]string)
+	for name, hash := range remote {
+		if _, ok := tracked[name]; ok {
+			files[name] = hash
+		}
+	}
+
+	return files
+}
+
+// objectStorageObjectSyncV1Changes returns the sorted names of the files,
+// which have to be uploaded and the names of the objects, which have to be
+// deleted to make remote match local.
+func objectStorageObjectSyncV1Changes(local, remote map[string]string) ([]string, []string) {
+	var upload, remove []string
+
+	for name, hash := range local {
+		if remote[name] != hash {
+			upload = append(upload, name)
+		}
+	}
+
+	for name := range remote {
+		if _, ok := local[name]; !ok {
+			remove = append(remove, name)
+		}
+	}
+
+	sort.Strings(upload)
+	sort.Strings(remove)
+
+	return upload, remove
+}
+
+// objectStorageObjectSyncV1Run calls fn for every name using up to
+// parallelism goroutines and returns the collected errors.
+func objectStorageObjectSyncV1Run(ctx context.Context, names []string, parallelism int, fn func(name string) error) error {
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		errs    []string
+		ctxErr  error
+		workers = make(chan struct{}, parallelism)
+	)
+
+	for _, name := range names {
+		name := name
+
+		// The context error is collected once the workers are done, as
+		// they append to errs concurrently.
+		if ctxErr = ctx.Err(); ctxErr != nil {
+			break
+		}
+
+		wg.Add(1)
+		workers <- struct{}{}
+		go func() {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+
+			if err := fn(name); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Sprintf("%s: %s", name, err))
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if ctxErr != nil {
+		errs = append(errs, ctxErr.Error())
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// objectStorageObjectSyncV1Upload uploads a single file. The precomputed MD5
+// checksum is sent as ETag, so that Swift verifies the upload.
+func objectStorageObjectSyncV1Upload(client *gophercloud.ServiceClient, containerName, objectName, filePath, hash string, metadata map[string]string, detectContentType bool) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fileinfo, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	createOpts := &objects.CreateOpts{
+		Content:       file,
+		ContentLength: fileinfo.Size(),
+		ETag:          hash,
+		Metadata:      metadata,
+	}
+
+	if detectContentType {
+		createOpts.DetectContentType = "true"
+	}
+
+	log.Printf("[DEBUG] Uploading %s to %s/%s", filePath, containerName, objectName)
+	_, err = objects.Create(client, containerName, objectName, createOpts).Extract()
+
+	return err
+}
+
+func objectStorageObjectSyncV1Delete(client *gophercloud.ServiceClient, containerName, objectName string) error {
+	log.Printf("[DEBUG] Deleting %s/%s", containerName, objectName)
+	_, err := objects.Delete(client, containerName, objectName, nil).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package openstack
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitObjectStorageObjectSyncV1Match(t *testing.T) {
+	testCases := []struct {
+		name     string
+		include  []string
+		exclude  []string
+		expected bool
+	}{
+		{"index.html", nil, nil, true},
+		{"css/site.css", []string{"*.css"}, nil, true},
+		{"css/site.css", []string{"css/*"}, nil, true},
+		{"index.html", []string{"*.css"}, nil, false},
+		{"css/site.css", nil, []string{"css/*"}, false},
+		{".git/config", nil, []string{".git/*"}, false},
+		{"css/site.css", []string{"*.css"}, []string{"site.css"}, false},
+	}
+
+	for _, tc := range testCases {
+		actual, err := objectStorageObjectSyncV1Match(tc.name, tc.include, tc.exclude)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, actual, tc.name)
+	}
+
+	_, err := objectStorageObjectSyncV1Match("index.html", []string{"["}, nil)
+	assert.Error(t, err)
+}
+
+func TestUnitObjectStorageObjectSyncV1Changes(t *testing.T) {
+	local := map[string]string{
+		"a": "1",
+		"b": "2",
+		"c": "3",
+	}
+	remote := map[string]string{
+		"b": "2",
+		"c": "4",
+		"d": "5",
+	}
+
+	upload, remove := objectStorageObjectSyncV1Changes(local, remote)
+	assert.Equal(t, []string{"a", "c"}, upload)
+	assert.Equal(t, []string{"d"}, remove)
+
+	upload, remove = objectStorageObjectSyncV1Changes(local, nil)
+	assert.Equal(t, []string{"a", "b", "c"}, upload)
+	assert.Empty(t, remove)
+}
+
+func TestUnitObjectStorageObjectSyncV1Tracked(t *testing.T) {
+	remote := map[string]string{
+		"index.html":   "aaa",
+		"css/site.css": "bbb",
+		"other.txt":    "ccc",
+	}
+
+	tracked := map[string]interface{}{
+		"index.html":   "aaa",
+		"css/site.css": "ddd",
+		"gone.txt":     "eee",
+	}
+
+	expected := map[string]string{
+		"index.html":   "aaa",
+		"css/site.css": "bbb",
+	}
+
+	assert.Equal(t, expected, objectStorageObjectSyncV1Tracked(remote, tracked))
+	assert.Empty(t, objectStorageObjectSyncV1Tracked(remote, nil))
+}
+
+func TestUnitObjectStorageObjectSyncV1RunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	names := []string{"a", "b", "c", "d", "e", "f"}
+	err := objectStorageObjectSyncV1Run(ctx, names, 2, func(name string) error {
+		cancel()
+		return fmt.Errorf("failed")
+	})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "a: failed")
+	assert.Contains(t, err.Error(), context.Canceled.Error())
+}
+
+func TestUnitObjectStorageObjectSyncV1LocalFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf_test_objectstorage_object_sync")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "css"), 0700))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("foo"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "css", "site.css"), []byte("bar"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "notes.txt"), []byte("baz"), 0600))
+
+	expected := map[string]string{
+		"index.html":   fmt.Sprintf("%x", md5.Sum([]byte("foo"))),
+		"css/site.css": fmt.Sprintf("%x", md5.Sum([]byte("bar"))),
+	}
+
+	actual, err := objectStorageObjectSyncV1LocalFiles(dir, nil, []string{"*.txt"})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	_, err = objectStorageObjectSyncV1LocalFiles(filepath.Join(dir, "missing"), nil, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,386 @@
+package openstack
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+const (
+	// objectStorageObjectV1MaxSize is the default maximum size of a single
+	// Swift object.
+	objectStorageObjectV1MaxSize int64 = 5 * 1024 * 1024 * 1024
+
+	// objectStorageObjectV1DefaultSegmentSize is the segment size used, when
+	// a source exceeds objectStorageObjectV1MaxSize and no segment_size is set.
+	objectStorageObjectV1DefaultSegmentSize int64 = 1024 * 1024 * 1024
+
+	// objectStorageObjectV1MinSegmentSize is the default minimum size of a
+	// static large object segment, except for the last one.
+	objectStorageObjectV1MinSegmentSize = 1024 * 1024
+)
+
+// objectStorageObjectV1SegmentNameRe matches the segment names generated by
+// objectStorageObjectV1SegmentName after the object prefix.
+var objectStorageObjectV1SegmentNameRe = regexp.MustCompile(`^\d+/\d+/\d{8}$`)
+
+type objectStorageObjectV1Segment struct {
+	Path      string `json:"path"`
+	ETag      string `json:"etag"`
+	SizeBytes int64  `json:"size_bytes"`
+}
+
+// objectStorageObjectV1SegmentSize returns the size of the segments a source
+// of the given size has to be split into, or 0 if it can be uploaded with a
+// single request.
+func objectStorageObjectV1SegmentSize(segmentSize, size int64) int64 {
+	if segmentSize > 0 {
+		if size > segmentSize {
+			return segmentSize
+		}
+		return 0
+	}
+
+	if size > objectStorageObjectV1MaxSize {
+		return objectStorageObjectV1DefaultSegmentSize
+	}
+
+	return 0
+}
+
+func objectStorageObjectV1SegmentContainer(d *schema.ResourceData, containerName string) string {
+	if v, ok := d.GetOk("segment_container"); ok {
+		return v.(string)
+	}
+
+	return containerName + "_segments"
+}
+
+// objectStorageObjectV1SegmentPrefix returns the prefix of all segments of an
+// object within the segment container.
+func objectStorageObjectV1SegmentPrefix(objectName, largeObjectType string) string {
+	return fmt.Sprintf("%s/%s/", objectName, largeObjectType)
+}
+
+func objectStorageObjectV1SegmentName(objectName, largeObjectType string, size, segmentSize int64, index int) string {
+	return fmt.Sprintf("%s%d/%d/%08d", objectStorageObjectV1SegmentPrefix(objectName, largeObjectType), size, segmentSize, index)
+}
+
+// objectStorageObjectV1HashSegments reads the source once and returns the
+// MD5 checksum of every segment together with the MD5 checksum of the whole
+// source.
+func objectStorageObjectV1HashSegments(source io.Reader, size, segmentSize int64) ([]objectStorageObjectV1Segment, string, error) {
+	fileHash := md5.New()
+	segments := make([]objectStorageObjectV1Segment, 0, size/segmentSize+1)
+
+	for offset := int64(0); offset < size; offset += segmentSize {
+		n := segmentSize
+		if offset+n > size {
+			n = size - offset
+		}
+
+		segmentHash := md5.New()
+		if _, err := io.CopyN(io.MultiWriter(fileHash, segmentHash), source, n); err != nil {
+			return nil, "", err
+		}
+
+		segments = append(segments, objectStorageObjectV1Segment{
+			ETag:      fmt.Sprintf("%x", segmentHash.Sum(nil)),
+			SizeBytes: n,
+		})
+	}
+
+	return segments, fmt.Sprintf("%x", fileHash.Sum(nil)), nil
+}
+
+// objectStorageObjectV1UploadSegments uploads all segments in parallel.
+// Segments, which already exist with the same ETag, either according to the
+// state or to Swift, are skipped. This allows to resume failed uploads.
+func objectStorageObjectV1UploadSegments(ctx context.Context, client *gophercloud.ServiceClient, source io.ReaderAt, segmentContainer string, segments []objectStorageObjectV1Segment, existing map[string]string, parallelism int) error {
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		errs    []string
+		offset  int64
+		workers = make(chan struct{}, parallelism)
+	)
+
+	for _, segment := range segments {
+		segment := segment
+		segmentOffset := offset
+		offset += segment.SizeBytes
+
+		if existing[segment.Path] == segment.ETag {
+			log.Printf("[DEBUG] Skipping upload of unchanged segment %s/%s", segmentContainer, segment.Path)
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		wg.Add(1)
+		workers <- struct{}{}
+		go func() {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+
+			header, err := objects.Get(client, segmentContainer, segment.Path, nil).Extract()
+			if err == nil && strings.Trim(header.ETag, `"`) == segment.ETag && header.ContentLength == segment.SizeBytes {
+				log.Printf("[DEBUG] Skipping upload of existing segment %s/%s", segmentContainer, segment.Path)
+				return
+			}
+
+			createOpts := &objects.CreateOpts{
+				Content:       io.NewSectionReader(source, segmentOffset, segment.SizeBytes),
+				ContentLength: segment.SizeBytes,
+				ETag:          segment.ETag,
+			}
+
+			log.Printf("[DEBUG] Uploading segment %s/%s", segmentContainer, segment.Path)
+			if _, err := objects.Create(client, segmentContainer, segment.Path, createOpts).Extract(); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Sprintf("%s: %s", segment.Path, err))
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return fmt.Errorf("Error uploading segments to %s: %s", segmentContainer, strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// objectStorageObjectV1CreateManifest creates the large object manifest. All
+// headers and metadata of createOpts are applied to the manifest.
+func objectStorageObjectV1CreateManifest(client *gophercloud.ServiceClient, containerName, objectName, largeObjectType, segmentContainer, manifestPrefix string, segments []objectStorageObjectV1Segment, createOpts *objects.CreateOpts) error {
+	createOpts.TransferEncoding = ""
+	createOpts.ETag = ""
+
+	switch largeObjectType {
+	case "dlo":
+		createOpts.Content = bytes.NewReader([]byte(""))
+		createOpts.ContentLength = 0
+		createOpts.ObjectManifest = segmentContainer + "/" + manifestPrefix
+	default:
+		manifest := make([]objectStorageObjectV1Segment, len(segments))
+		for i, segment := range segments {
+			manifest[i] = segment
+			manifest[i].Path = segmentContainer + "/" + segment.Path
+		}
+
+		b, err := json.Marshal(manifest)
+		if err != nil {
+			return err
+		}
+
+		// Swift validates the ETag of a static large object manifest against
+		// the segments, not against the manifest body.
+		createOpts.NoETag = true
+		createOpts.Content = bytes.NewReader(b)
+		createOpts.ContentLength = int64(len(b))
+		createOpts.MultipartManifest = "put"
+	}
+
+	log.Printf("[DEBUG] Create large object manifest Options: %#v", createOpts)
+	_, err := objects.Create(client, containerName, objectName, createOpts).Extract()
+
+	return err
+}
+
+// objectStorageObjectV1DeleteOrphanedSegments deletes all segments under the
+// segment prefix of an object, which aren't part of keep.
+func objectStorageObjectV1DeleteOrphanedSegments(client *gophercloud.ServiceClient, segmentContainer, segmentPrefix string, keep map[string]bool) error {
+	allPages, err := objects.List(client, segmentContainer, &objects.ListOpts{Prefix: segmentPrefix}).AllPages()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil
+		}
+		return err
+	}
+
+	names, err := objects.ExtractNames(allPages)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if keep[name] || !objectStorageObjectV1SegmentNameRe.MatchString(strings.TrimPrefix(name, segmentPrefix)) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting orphaned segment %s/%s", segmentContainer, name)
+		_, err := objects.Delete(client, segmentContainer, name, nil).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// objectStorageObjectV1UploadLargeObject uploads the source in segments and
+// creates a static or dynamic large object manifest for them. Segments of a
+// previous upload, which are no longer used, are deleted afterwards.
+func objectStorageObjectV1UploadLargeObject(ctx context.Context, d *schema.ResourceData, client *gophercloud.ServiceClient, containerName, objectName string, source *os.File, size, segmentSize int64, createOpts *objects.CreateOpts) error {
+	largeObjectType := d.Get("large_object_type").(string)
+	segmentContainer := objectStorageObjectV1SegmentContainer(d, containerName)
+	segmentPrefix := objectStorageObjectV1SegmentPrefix(objectName, largeObjectType)
+
+	if err := containers.Create(client, segmentContainer, nil).Err; err != nil {
+		return fmt.Errorf("Error creating OpenStack segment container %s: %s", segmentContainer, err)
+	}
+
+	segments, fileMD5, err := objectStorageObjectV1HashSegments(source, size, segmentSize)
+	if err != nil {
+		return fmt.Errorf("Error reading openstack swift object source: %s", err)
+	}
+
+	if createOpts.ETag != "" && createOpts.ETag != fileMD5 {
+		return fmt.Errorf("The MD5 checksum of the source %s doesn't match the etag %s", fileMD5, createOpts.ETag)
+	}
+
+	keep := make(map[string]bool, len(segments))
+	for i := range segments {
+		segments[i].Path = objectStorageObjectV1SegmentName(objectName, largeObjectType, size, segmentSize, i)
+		keep[segments[i].Path] = true
+	}
+
+	existing := make(map[string]string)
+	if oldContainer, oldSegments := objectStorageObjectV1StateSegments(d); oldContainer == segmentContainer {
+		for _, v := range oldSegments {
+			existing[v.Path] = v.ETag
+		}
+	}
+
+	parallelism := d.Get("segment_parallelism").(int)
+	if err := objectStorageObjectV1UploadSegments(ctx, client, source, segmentContainer, segments, existing, parallelism); err != nil {
+		return err
+	}
+
+	// A dynamic large object consists of all segments under its prefix, so
+	// it has to be limited to the segments of this upload.
+	manifestPrefix := strings.TrimSuffix(segments[0].Path, "00000000")
+	if err := objectStorageObjectV1CreateManifest(client, containerName, objectName, largeObjectType, segmentContainer, manifestPrefix, segments, createOpts); err != nil {
+		return fmt.Errorf("Error creating OpenStack large object manifest: %s", err)
+	}
+
+	// Clean up the segments of previous or failed uploads. Segments of a
+	// previous upload into another segment container are only known from
+	// the state.
+	if err := objectStorageObjectV1DeleteOrphanedSegments(client, segmentContainer, segmentPrefix, keep); err != nil {
+		return fmt.Errorf("Error deleting orphaned segments of %s/%s: %s", containerName, objectName, err)
+	}
+	oldContainer, oldSegments := objectStorageObjectV1StateSegments(d)
+	if oldContainer != segmentContainer {
+		keep = nil
+	}
+	if err := objectStorageObjectV1DeleteSegments(client, oldContainer, oldSegments, keep); err != nil {
+		return fmt.Errorf("Error deleting orphaned segments of %s/%s: %s", containerName, objectName, err)
+	}
+
+	d.Set("etag", fileMD5)
+	d.Set("segment_container", segmentContainer)
+	d.Set("segments", flattenObjectStorageObjectV1Segments(segments))
+
+	return nil
+}
+
+// objectStorageObjectV1DeleteSegments deletes the given segments, which
+// aren't part of keep.
+func objectStorageObjectV1DeleteSegments(client *gophercloud.ServiceClient, segmentContainer string, segments []objectStorageObjectV1Segment, keep map[string]bool) error {
+	for _, segment := range segments {
+		if keep[segment.Path] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting segment %s/%s", segmentContainer, segment.Path)
+		_, err := objects.Delete(client, segmentContainer, segment.Path, nil).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// objectStorageObjectV1StateSegments returns the segment container and the
+// segments of the last successful large object upload.
+func objectStorageObjectV1StateSegments(d *schema.ResourceData) (string, []objectStorageObjectV1Segment) {
+	oldContainer, _ := d.GetChange("segment_container")
+	oldSegments, _ := d.GetChange("segments")
+
+	return oldContainer.(string), expandObjectStorageObjectV1Segments(oldSegments.([]interface{}))
+}
+
+func expandObjectStorageObjectV1Segments(raw []interface{}) []objectStorageObjectV1Segment {
+	segments := make([]objectStorageObjectV1Segment, 0, len(raw))
+	for _, v := range raw {
+		segment, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		segments = append(segments, objectStorageObjectV1Segment{
+			Path:      segment["path"].(string),
+			ETag:      segment["etag"].(string),
+			SizeBytes: int64(segment["size_bytes"].(int)),
+		})
+	}
+
+	return segments
+}
+
+func flattenObjectStorageObjectV1Segments(segments []objectStorageObjectV1Segment) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(segments))
+	for _, segment := range segments {
+		res = append(res, map[string]interface{}{
+			"path":       segment.Path,
+			"etag":       segment.ETag,
+			"size_bytes": int(segment.SizeBytes),
+		})
+	}
+
+	return res
+}
+
+// objectStorageObjectV1TextBody returns content as a string, if it's valid
+// UTF-8 text and not larger than maxSize.
+func objectStorageObjectV1TextBody(content []byte, maxSize int64) (string, error) {
+	if int64(len(content)) > maxSize {
+		return "", fmt.Errorf("The object size of %d bytes exceeds the max_body_size of %d bytes", len(content), maxSize)
+	}
+
+	if !utf8.Valid(content) {
+		return "", fmt.Errorf("The object body isn't valid UTF-8 text")
+	}
+
+	return string(content), nil
+}
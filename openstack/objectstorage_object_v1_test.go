@@ -0,0 +1,72 @@
+package openstack
+
+import (
+	"crypto/md5"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitObjectStorageObjectV1SegmentSize(t *testing.T) {
+	assert.Equal(t, int64(0), objectStorageObjectV1SegmentSize(0, 1024))
+	assert.Equal(t, int64(0), objectStorageObjectV1SegmentSize(0, objectStorageObjectV1MaxSize))
+	assert.Equal(t, objectStorageObjectV1DefaultSegmentSize, objectStorageObjectV1SegmentSize(0, objectStorageObjectV1MaxSize+1))
+	assert.Equal(t, int64(0), objectStorageObjectV1SegmentSize(3, 3))
+	assert.Equal(t, int64(3), objectStorageObjectV1SegmentSize(3, 4))
+}
+
+func TestUnitObjectStorageObjectV1SegmentName(t *testing.T) {
+	name := objectStorageObjectV1SegmentName("foo/bar", "slo", 6, 3, 1)
+	prefix := objectStorageObjectV1SegmentPrefix("foo/bar", "slo")
+
+	assert.Equal(t, "foo/bar/slo/6/3/00000001", name)
+	assert.True(t, strings.HasPrefix(name, prefix))
+	assert.True(t, objectStorageObjectV1SegmentNameRe.MatchString(strings.TrimPrefix(name, prefix)))
+	assert.False(t, objectStorageObjectV1SegmentNameRe.MatchString("6/3/00000001/baz"))
+}
+
+func TestUnitObjectStorageObjectV1HashSegments(t *testing.T) {
+	segments, fileMD5, err := objectStorageObjectV1HashSegments(strings.NewReader("foobarba"), 8, 3)
+	assert.NoError(t, err)
+
+	expected := []objectStorageObjectV1Segment{
+		{ETag: fmt.Sprintf("%x", md5.Sum([]byte("foo"))), SizeBytes: 3},
+		{ETag: fmt.Sprintf("%x", md5.Sum([]byte("bar"))), SizeBytes: 3},
+		{ETag: fmt.Sprintf("%x", md5.Sum([]byte("ba"))), SizeBytes: 2},
+	}
+
+	assert.Equal(t, expected, segments)
+	assert.Equal(t, fmt.Sprintf("%x", md5.Sum([]byte("foobarba"))), fileMD5)
+
+	_, _, err = objectStorageObjectV1HashSegments(strings.NewReader("foo"), 8, 3)
+	assert.Error(t, err)
+}
+
+func TestUnitObjectStorageObjectV1Segments(t *testing.T) {
+	segments := []objectStorageObjectV1Segment{
+		{Path: "foo/slo/6/3/00000000", ETag: "acbd18db4cc2f85cedef654fccc4a4d8", SizeBytes: 3},
+		{Path: "foo/slo/6/3/00000001", ETag: "37b51d194a7513e45b56f6524f2d51f2", SizeBytes: 3},
+	}
+
+	flattened := flattenObjectStorageObjectV1Segments(segments)
+	raw := make([]interface{}, len(flattened))
+	for i, v := range flattened {
+		raw[i] = v
+	}
+
+	assert.Equal(t, segments, expandObjectStorageObjectV1Segments(raw))
+}
+
+func TestUnitObjectStorageObjectV1TextBody(t *testing.T) {
+	body, err := objectStorageObjectV1TextBody([]byte("foo: bar\n"), 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo: bar\n", body)
+
+	_, err = objectStorageObjectV1TextBody([]byte("foobar"), 3)
+	assert.Error(t, err)
+
+	_, err = objectStorageObjectV1TextBody([]byte{0xff, 0xfe, 0xfd}, 1024)
+	assert.Error(t, err)
+}
@@ -1,14 +1,16 @@
 package openstack
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stackresources"
 	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
 )
 
@@ -40,7 +42,14 @@ func buildTE(t map[string]interface{}) (*stacks.TE, error) {
 	return te, nil
 }
 
-func buildTemplateOpts(d *schema.ResourceData) (*stacks.Template, error) {
+// orchestrationStackV1ResourceData is satisfied by both *schema.ResourceData
+// and *schema.ResourceDiff, so that the template and environment options can
+// be built during both apply and plan.
+type orchestrationStackV1ResourceData interface {
+	Get(key string) interface{}
+}
+
+func buildTemplateOpts(d orchestrationStackV1ResourceData) (*stacks.Template, error) {
 	log.Printf("[DEBUG] Start building TemplateOpts")
 	te, err := buildTE(d.Get("template_opts").(map[string]interface{}))
 	if err != nil {
@@ -52,7 +61,7 @@ func buildTemplateOpts(d *schema.ResourceData) (*stacks.Template, error) {
 	}, nil
 }
 
-func buildEnvironmentOpts(d *schema.ResourceData) (*stacks.Environment, error) {
+func buildEnvironmentOpts(d orchestrationStackV1ResourceData) (*stacks.Environment, error) {
 	log.Printf("[DEBUG] Start building EnvironmentOpts")
 	if d.Get("environment_opts") != nil {
 		t := d.Get("environment_opts").(map[string]interface{})
@@ -89,3 +98,174 @@ func orchestrationStackV1StateRefreshFunc(client *gophercloud.ServiceClient, sta
 		return stack, stack.Status, nil
 	}
 }
+
+// flattenOrchestrationStackV1Outputs converts the stack outputs into a list
+// of output blocks. Output values, which aren't strings, are JSON encoded.
+func flattenOrchestrationStackV1Outputs(stackOutputs []map[string]interface{}) []map[string]interface{} {
+	outputs := make([]map[string]interface{}, 0, len(stackOutputs))
+	for _, o := range stackOutputs {
+		output := make(map[string]interface{})
+		output["description"] = o["description"]
+		output["output_key"] = o["output_key"]
+		output["output_value"] = o["output_value"]
+
+		if v, ok := o["output_value"]; ok && v != nil {
+			if _, ok := v.(string); !ok {
+				value, err := json.Marshal(v)
+				if err != nil {
+					log.Printf("[DEBUG] Unable to encode openstack_orchestration_stack_v1 output %v: %s", o["output_key"], err)
+				} else {
+					output["output_value"] = string(value)
+				}
+			}
+		}
+
+		outputs = append(outputs, output)
+	}
+
+	return outputs
+}
+
+// orchestrationStackV1CheckStateRefreshFunc is like
+// orchestrationStackV1StateRefreshFunc, but doesn't treat CHECK_FAILED as an
+// error, since a failed check is a valid result which is reported back.
+func orchestrationStackV1CheckStateRefreshFunc(client *gophercloud.ServiceClient, stackID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		stack, err := stacks.Find(client, stackID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		return stack, stack.Status, nil
+	}
+}
+
+// orchestrationStackV1Check triggers the check action of a stack. Heat
+// verifies that every resource of the stack still exists and is in the
+// expected state.
+func orchestrationStackV1Check(client *gophercloud.ServiceClient, stackName, stackID string) error {
+	b := map[string]interface{}{
+		"check": nil,
+	}
+
+	_, err := client.Post(client.ServiceURL("stacks", stackName, stackID, "actions"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+// orchestrationStackV1DriftedResources returns the resources of a stack,
+// which failed the last stack check.
+func orchestrationStackV1DriftedResources(client *gophercloud.ServiceClient, stackName, stackID string) ([]map[string]interface{}, error) {
+	allPages, err := stackresources.List(client, stackName, stackID, stackresources.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allResources, err := stackresources.ExtractResources(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	drifted := make([]map[string]interface{}, 0)
+	for _, r := range allResources {
+		if r.Status != "CHECK_FAILED" {
+			continue
+		}
+
+		drifted = append(drifted, map[string]interface{}{
+			"resource_name":        r.Name,
+			"resource_type":        r.Type,
+			"physical_resource_id": r.PhysicalID,
+			"status":               r.Status,
+			"status_reason":        r.StatusReason,
+		})
+	}
+
+	return drifted, nil
+}
+
+type orchestrationStackV1PreviewResource struct {
+	Name string `json:"resource_name"`
+	Type string `json:"resource_type"`
+}
+
+type orchestrationStackV1UpdatePreview struct {
+	Added     []orchestrationStackV1PreviewResource `json:"added"`
+	Deleted   []orchestrationStackV1PreviewResource `json:"deleted"`
+	Replaced  []orchestrationStackV1PreviewResource `json:"replaced"`
+	Unchanged []orchestrationStackV1PreviewResource `json:"unchanged"`
+	Updated   []orchestrationStackV1PreviewResource `json:"updated"`
+}
+
+// orchestrationStackV1PreviewUpdate asks Heat which resources would be
+// added, deleted, replaced or updated if the stack was updated with opts.
+// Nothing is changed on the stack itself.
+func orchestrationStackV1PreviewUpdate(client *gophercloud.ServiceClient, stackName, stackID string, opts stacks.UpdateOpts) (*orchestrationStackV1UpdatePreview, error) {
+	b, err := opts.ToStackUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		ResourceChanges orchestrationStackV1UpdatePreview `json:"resource_changes"`
+	}
+	_, err = client.Put(client.ServiceURL("stacks", stackName, stackID, "preview"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.ResourceChanges, nil
+}
+
+func flattenOrchestrationStackV1PreviewResources(resources []orchestrationStackV1PreviewResource) []string {
+	names := make([]string, 0, len(resources))
+	for _, r := range resources {
+		names = append(names, r.Name)
+	}
+
+	return names
+}
+
+func flattenOrchestrationStackV1UpdatePreview(preview *orchestrationStackV1UpdatePreview) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"added":     flattenOrchestrationStackV1PreviewResources(preview.Added),
+			"deleted":   flattenOrchestrationStackV1PreviewResources(preview.Deleted),
+			"replaced":  flattenOrchestrationStackV1PreviewResources(preview.Replaced),
+			"unchanged": flattenOrchestrationStackV1PreviewResources(preview.Unchanged),
+			"updated":   flattenOrchestrationStackV1PreviewResources(preview.Updated),
+		},
+	}
+}
+
+// orchestrationStackV1UpdatePreviewDiagnostics converts the destructive
+// changes of the update_preview planned for an applied update into warnings.
+// They are a record of what the update did, as SDKv2 can't emit warnings
+// during plan.
+func orchestrationStackV1UpdatePreviewDiagnostics(stackName string, rawPreview []interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(rawPreview) == 0 || rawPreview[0] == nil {
+		return diags
+	}
+	preview := rawPreview[0].(map[string]interface{})
+
+	for _, action := range []string{"replaced", "deleted"} {
+		names := expandToStringSlice(preview[action].([]interface{}))
+		if len(names) == 0 {
+			continue
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The update of openstack_orchestration_stack_v1 %s %s stack resources", stackName, action),
+			Detail:   fmt.Sprintf("The following stack resources were %s by the applied update: %s", action, strings.Join(names, ", ")),
+		})
+	}
+
+	return diags
+}
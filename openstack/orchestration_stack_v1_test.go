@@ -0,0 +1,82 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestUnitFlattenOrchestrationStackV1UpdatePreview(t *testing.T) {
+	preview := &orchestrationStackV1UpdatePreview{
+		Replaced: []orchestrationStackV1PreviewResource{
+			{Name: "random", Type: "OS::Heat::RandomString"},
+		},
+		Unchanged: []orchestrationStackV1PreviewResource{
+			{Name: "test_res", Type: "OS::Heat::TestResource"},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"added":     []string{},
+			"deleted":   []string{},
+			"replaced":  []string{"random"},
+			"unchanged": []string{"test_res"},
+			"updated":   []string{},
+		},
+	}
+
+	assert.Equal(t, expected, flattenOrchestrationStackV1UpdatePreview(preview))
+}
+
+func TestUnitOrchestrationStackV1UpdatePreviewDiagnostics(t *testing.T) {
+	rawPreview := []interface{}{
+		map[string]interface{}{
+			"added":     []interface{}{"new"},
+			"deleted":   []interface{}{"old"},
+			"replaced":  []interface{}{"random", "server"},
+			"unchanged": []interface{}{},
+			"updated":   []interface{}{"test_res"},
+		},
+	}
+
+	diags := orchestrationStackV1UpdatePreviewDiagnostics("stack_1", rawPreview)
+	assert.Len(t, diags, 2)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "The following stack resources were replaced by the applied update: random, server", diags[0].Detail)
+	assert.Equal(t, "The following stack resources were deleted by the applied update: old", diags[1].Detail)
+
+	assert.Empty(t, orchestrationStackV1UpdatePreviewDiagnostics("stack_1", nil))
+}
+
+func TestUnitFlattenOrchestrationStackV1Outputs(t *testing.T) {
+	stackOutputs := []map[string]interface{}{
+		{
+			"description":  "A string output",
+			"output_key":   "value1",
+			"output_value": "foo",
+		},
+		{
+			"description":  "A list output",
+			"output_key":   "value2",
+			"output_value": []interface{}{"foo", "bar"},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"description":  "A string output",
+			"output_key":   "value1",
+			"output_value": "foo",
+		},
+		{
+			"description":  "A list output",
+			"output_key":   "value2",
+			"output_value": `["foo","bar"]`,
+		},
+	}
+
+	assert.Equal(t, expected, flattenOrchestrationStackV1Outputs(stackOutputs))
+}
@@ -265,167 +265,203 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"openstack_blockstorage_availability_zones_v3":       dataSourceBlockStorageAvailabilityZonesV3(),
-			"openstack_blockstorage_snapshot_v2":                 dataSourceBlockStorageSnapshotV2(),
-			"openstack_blockstorage_snapshot_v3":                 dataSourceBlockStorageSnapshotV3(),
-			"openstack_blockstorage_volume_v2":                   dataSourceBlockStorageVolumeV2(),
-			"vtidc_blockstorage_volume_v3":                       dataSourceBlockStorageVolumeV3(),
-			"openstack_blockstorage_quotaset_v3":                 dataSourceBlockStorageQuotasetV3(),
-			"openstack_compute_aggregate_v2":                     dataSourceComputeAggregateV2(),
-			"openstack_compute_availability_zones_v2":            dataSourceComputeAvailabilityZonesV2(),
-			"openstack_compute_instance_v2":                      dataSourceComputeInstanceV2(),
-			"openstack_compute_flavor_v2":                        dataSourceComputeFlavorV2(),
-			"openstack_compute_hypervisor_v2":                    dataSourceComputeHypervisorV2(),
-			"openstack_compute_keypair_v2":                       dataSourceComputeKeypairV2(),
-			"openstack_compute_quotaset_v2":                      dataSourceComputeQuotasetV2(),
-			"openstack_compute_limits_v2":                        dataSourceComputeLimitsV2(),
-			"openstack_containerinfra_nodegroup_v1":              dataSourceContainerInfraNodeGroupV1(),
-			"openstack_containerinfra_clustertemplate_v1":        dataSourceContainerInfraClusterTemplateV1(),
-			"openstack_containerinfra_cluster_v1":                dataSourceContainerInfraCluster(),
-			"openstack_dns_zone_v2":                              dataSourceDNSZoneV2(),
-			"openstack_fw_group_v2":                              dataSourceFWGroupV2(),
-			"openstack_fw_policy_v1":                             dataSourceFWPolicyV1(),
-			"openstack_fw_policy_v2":                             dataSourceFWPolicyV2(),
-			"openstack_fw_rule_v2":                               dataSourceFWRuleV2(),
-			"openstack_identity_role_v3":                         dataSourceIdentityRoleV3(),
-			"openstack_identity_project_v3":                      dataSourceIdentityProjectV3(),
-			"openstack_identity_user_v3":                         dataSourceIdentityUserV3(),
-			"openstack_identity_auth_scope_v3":                   dataSourceIdentityAuthScopeV3(),
-			"openstack_identity_endpoint_v3":                     dataSourceIdentityEndpointV3(),
-			"openstack_identity_service_v3":                      dataSourceIdentityServiceV3(),
-			"openstack_identity_group_v3":                        dataSourceIdentityGroupV3(),
-			"openstack_images_image_v2":                          dataSourceImagesImageV2(),
-			"openstack_images_image_ids_v2":                      dataSourceImagesImageIDsV2(),
-			"openstack_networking_addressscope_v2":               dataSourceNetworkingAddressScopeV2(),
-			"openstack_networking_network_v2":                    dataSourceNetworkingNetworkV2(),
-			"openstack_networking_qos_bandwidth_limit_rule_v2":   dataSourceNetworkingQoSBandwidthLimitRuleV2(),
-			"openstack_networking_qos_dscp_marking_rule_v2":      dataSourceNetworkingQoSDSCPMarkingRuleV2(),
-			"openstack_networking_qos_minimum_bandwidth_rule_v2": dataSourceNetworkingQoSMinimumBandwidthRuleV2(),
-			"openstack_networking_qos_policy_v2":                 dataSourceNetworkingQoSPolicyV2(),
-			"openstack_networking_quota_v2":                      dataSourceNetworkingQuotaV2(),
-			"openstack_networking_subnet_v2":                     dataSourceNetworkingSubnetV2(),
-			"openstack_networking_subnet_ids_v2":                 dataSourceNetworkingSubnetIDsV2(),
-			"openstack_networking_secgroup_v2":                   dataSourceNetworkingSecGroupV2(),
-			"openstack_networking_subnetpool_v2":                 dataSourceNetworkingSubnetPoolV2(),
-			"openstack_networking_floatingip_v2":                 dataSourceNetworkingFloatingIPV2(),
-			"openstack_networking_router_v2":                     dataSourceNetworkingRouterV2(),
-			"openstack_networking_port_v2":                       dataSourceNetworkingPortV2(),
-			"openstack_networking_port_ids_v2":                   dataSourceNetworkingPortIDsV2(),
-			"openstack_networking_trunk_v2":                      dataSourceNetworkingTrunkV2(),
-			"openstack_sharedfilesystem_availability_zones_v2":   dataSourceSharedFilesystemAvailabilityZonesV2(),
-			"openstack_sharedfilesystem_sharenetwork_v2":         dataSourceSharedFilesystemShareNetworkV2(),
-			"openstack_sharedfilesystem_share_v2":                dataSourceSharedFilesystemShareV2(),
-			"openstack_sharedfilesystem_snapshot_v2":             dataSourceSharedFilesystemSnapshotV2(),
-			"openstack_keymanager_secret_v1":                     dataSourceKeyManagerSecretV1(),
-			"openstack_keymanager_container_v1":                  dataSourceKeyManagerContainerV1(),
+			"openstack_blockstorage_availability_zones_v3":         dataSourceBlockStorageAvailabilityZonesV3(),
+			"openstack_blockstorage_snapshot_v2":                   dataSourceBlockStorageSnapshotV2(),
+			"openstack_blockstorage_snapshot_v3":                   dataSourceBlockStorageSnapshotV3(),
+			"openstack_blockstorage_volume_v2":                     dataSourceBlockStorageVolumeV2(),
+			"vtidc_blockstorage_volume_v3":                         dataSourceBlockStorageVolumeV3(),
+			"openstack_blockstorage_quotaset_v3":                   dataSourceBlockStorageQuotasetV3(),
+			"openstack_compute_aggregate_v2":                       dataSourceComputeAggregateV2(),
+			"openstack_compute_availability_zones_v2":              dataSourceComputeAvailabilityZonesV2(),
+			"openstack_compute_instance_v2":                        dataSourceComputeInstanceV2(),
+			"openstack_compute_flavor_v2":                          dataSourceComputeFlavorV2(),
+			"openstack_compute_hypervisor_v2":                      dataSourceComputeHypervisorV2(),
+			"openstack_compute_keypair_v2":                         dataSourceComputeKeypairV2(),
+			"openstack_compute_quotaset_v2":                        dataSourceComputeQuotasetV2(),
+			"openstack_compute_limits_v2":                          dataSourceComputeLimitsV2(),
+			"openstack_containerinfra_nodegroup_v1":                dataSourceContainerInfraNodeGroupV1(),
+			"openstack_containerinfra_clustertemplate_v1":          dataSourceContainerInfraClusterTemplateV1(),
+			"openstack_containerinfra_cluster_v1":                  dataSourceContainerInfraCluster(),
+			"openstack_containerinfra_kubeconfig_v1":               dataSourceContainerInfraKubeconfigV1(),
+			"openstack_db_datastore_v1":                            dataSourceDatabaseDatastoreV1(),
+			"openstack_db_datastore_version_v1":                    dataSourceDatabaseDatastoreVersionV1(),
+			"openstack_db_configuration_parameters_v1":             dataSourceDatabaseConfigurationParametersV1(),
+			"openstack_dns_zone_export_v2":                         dataSourceDNSZoneExportV2(),
+			"openstack_dns_zone_v2":                                dataSourceDNSZoneV2(),
+			"openstack_fw_group_v2":                                dataSourceFWGroupV2(),
+			"openstack_fw_policy_v1":                               dataSourceFWPolicyV1(),
+			"openstack_fw_policy_v2":                               dataSourceFWPolicyV2(),
+			"openstack_fw_rule_v2":                                 dataSourceFWRuleV2(),
+			"openstack_identity_role_v3":                           dataSourceIdentityRoleV3(),
+			"openstack_identity_project_v3":                        dataSourceIdentityProjectV3(),
+			"openstack_identity_user_v3":                           dataSourceIdentityUserV3(),
+			"openstack_identity_auth_scope_v3":                     dataSourceIdentityAuthScopeV3(),
+			"openstack_identity_endpoint_v3":                       dataSourceIdentityEndpointV3(),
+			"openstack_identity_service_v3":                        dataSourceIdentityServiceV3(),
+			"openstack_identity_group_v3":                          dataSourceIdentityGroupV3(),
+			"openstack_images_image_v2":                            dataSourceImagesImageV2(),
+			"openstack_images_image_ids_v2":                        dataSourceImagesImageIDsV2(),
+			"openstack_networking_addressscope_v2":                 dataSourceNetworkingAddressScopeV2(),
+			"openstack_networking_network_v2":                      dataSourceNetworkingNetworkV2(),
+			"openstack_networking_network_ip_availability_v2":      dataSourceNetworkingNetworkIPAvailabilityV2(),
+			"openstack_networking_qos_bandwidth_limit_rule_v2":     dataSourceNetworkingQoSBandwidthLimitRuleV2(),
+			"openstack_networking_qos_dscp_marking_rule_v2":        dataSourceNetworkingQoSDSCPMarkingRuleV2(),
+			"openstack_networking_qos_minimum_bandwidth_rule_v2":   dataSourceNetworkingQoSMinimumBandwidthRuleV2(),
+			"openstack_networking_qos_minimum_packet_rate_rule_v2": dataSourceNetworkingQoSMinimumPacketRateRuleV2(),
+			"openstack_networking_qos_packet_rate_limit_rule_v2":   dataSourceNetworkingQoSPacketRateLimitRuleV2(),
+			"openstack_networking_qos_policy_v2":                   dataSourceNetworkingQoSPolicyV2(),
+			"openstack_networking_quota_v2":                        dataSourceNetworkingQuotaV2(),
+			"openstack_networking_subnet_v2":                       dataSourceNetworkingSubnetV2(),
+			"openstack_networking_subnet_ids_v2":                   dataSourceNetworkingSubnetIDsV2(),
+			"openstack_networking_secgroup_v2":                     dataSourceNetworkingSecGroupV2(),
+			"openstack_networking_subnetpool_v2":                   dataSourceNetworkingSubnetPoolV2(),
+			"openstack_networking_floatingip_v2":                   dataSourceNetworkingFloatingIPV2(),
+			"openstack_networking_router_v2":                       dataSourceNetworkingRouterV2(),
+			"openstack_networking_port_v2":                         dataSourceNetworkingPortV2(),
+			"openstack_networking_port_ids_v2":                     dataSourceNetworkingPortIDsV2(),
+			"openstack_networking_trunk_v2":                        dataSourceNetworkingTrunkV2(),
+			"openstack_objectstorage_container_v1":                 dataSourceObjectStorageContainerV1(),
+			"openstack_objectstorage_object_v1":                    dataSourceObjectStorageObjectV1(),
+			"openstack_orchestration_stack_v1":                     dataSourceOrchestrationStackV1(),
+			"openstack_orchestration_stack_resources_v1":           dataSourceOrchestrationStackResourcesV1(),
+			"openstack_sharedfilesystem_availability_zones_v2":     dataSourceSharedFilesystemAvailabilityZonesV2(),
+			"openstack_sharedfilesystem_sharenetwork_v2":           dataSourceSharedFilesystemShareNetworkV2(),
+			"openstack_sharedfilesystem_share_v2":                  dataSourceSharedFilesystemShareV2(),
+			"openstack_sharedfilesystem_snapshot_v2":               dataSourceSharedFilesystemSnapshotV2(),
+			"openstack_keymanager_secret_v1":                       dataSourceKeyManagerSecretV1(),
+			"openstack_keymanager_container_v1":                    dataSourceKeyManagerContainerV1(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"openstack_blockstorage_qos_association_v3":          resourceBlockStorageQosAssociationV3(),
-			"openstack_blockstorage_qos_v3":                      resourceBlockStorageQosV3(),
-			"openstack_blockstorage_quotaset_v2":                 resourceBlockStorageQuotasetV2(),
-			"openstack_blockstorage_quotaset_v3":                 resourceBlockStorageQuotasetV3(),
-			"openstack_blockstorage_volume_v1":                   resourceBlockStorageVolumeV1(),
-			"openstack_blockstorage_volume_v2":                   resourceBlockStorageVolumeV2(),
-			"vtidc_blockstorage_volume_v3":                       resourceBlockStorageVolumeV3(),
-			"openstack_blockstorage_volume_attach_v2":            resourceBlockStorageVolumeAttachV2(),
-			"openstack_blockstorage_volume_attach_v3":            resourceBlockStorageVolumeAttachV3(),
-			"openstack_blockstorage_volume_type_access_v3":       resourceBlockstorageVolumeTypeAccessV3(),
-			"openstack_blockstorage_volume_type_v3":              resourceBlockStorageVolumeTypeV3(),
-			"openstack_compute_aggregate_v2":                     resourceComputeAggregateV2(),
-			"openstack_compute_flavor_v2":                        resourceComputeFlavorV2(),
-			"openstack_compute_flavor_access_v2":                 resourceComputeFlavorAccessV2(),
-			"openstack_compute_instance_v2":                      resourceComputeInstanceV2(),
-			"openstack_compute_interface_attach_v2":              resourceComputeInterfaceAttachV2(),
-			"openstack_compute_keypair_v2":                       resourceComputeKeypairV2(),
-			"openstack_compute_secgroup_v2":                      resourceComputeSecGroupV2(),
-			"openstack_compute_servergroup_v2":                   resourceComputeServerGroupV2(),
-			"openstack_compute_quotaset_v2":                      resourceComputeQuotasetV2(),
-			"openstack_compute_floatingip_v2":                    resourceComputeFloatingIPV2(),
-			"openstack_compute_floatingip_associate_v2":          resourceComputeFloatingIPAssociateV2(),
-			"openstack_compute_volume_attach_v2":                 resourceComputeVolumeAttachV2(),
-			"openstack_containerinfra_nodegroup_v1":              resourceContainerInfraNodeGroupV1(),
-			"openstack_containerinfra_clustertemplate_v1":        resourceContainerInfraClusterTemplateV1(),
-			"openstack_containerinfra_cluster_v1":                resourceContainerInfraClusterV1(),
-			"openstack_db_instance_v1":                           resourceDatabaseInstanceV1(),
-			"openstack_db_user_v1":                               resourceDatabaseUserV1(),
-			"openstack_db_configuration_v1":                      resourceDatabaseConfigurationV1(),
-			"openstack_db_database_v1":                           resourceDatabaseDatabaseV1(),
-			"openstack_dns_recordset_v2":                         resourceDNSRecordSetV2(),
-			"openstack_dns_zone_v2":                              resourceDNSZoneV2(),
-			"openstack_dns_transfer_request_v2":                  resourceDNSTransferRequestV2(),
-			"openstack_dns_transfer_accept_v2":                   resourceDNSTransferAcceptV2(),
-			"openstack_fw_firewall_v1":                           resourceFWFirewallV1(),
-			"openstack_fw_group_v2":                              resourceFWGroupV2(),
-			"openstack_fw_policy_v1":                             resourceFWPolicyV1(),
-			"openstack_fw_policy_v2":                             resourceFWPolicyV2(),
-			"openstack_fw_rule_v1":                               resourceFWRuleV1(),
-			"openstack_fw_rule_v2":                               resourceFWRuleV2(),
-			"openstack_identity_endpoint_v3":                     resourceIdentityEndpointV3(),
-			"openstack_identity_project_v3":                      resourceIdentityProjectV3(),
-			"openstack_identity_role_v3":                         resourceIdentityRoleV3(),
-			"openstack_identity_role_assignment_v3":              resourceIdentityRoleAssignmentV3(),
-			"openstack_identity_inherit_role_assignment_v3":      resourceIdentityInheritRoleAssignmentV3(),
-			"openstack_identity_service_v3":                      resourceIdentityServiceV3(),
-			"openstack_identity_user_v3":                         resourceIdentityUserV3(),
-			"openstack_identity_user_membership_v3":              resourceIdentityUserMembershipV3(),
-			"openstack_identity_group_v3":                        resourceIdentityGroupV3(),
-			"openstack_identity_application_credential_v3":       resourceIdentityApplicationCredentialV3(),
-			"openstack_identity_ec2_credential_v3":               resourceIdentityEc2CredentialV3(),
-			"openstack_images_image_v2":                          resourceImagesImageV2(),
-			"openstack_images_image_access_v2":                   resourceImagesImageAccessV2(),
-			"openstack_images_image_access_accept_v2":            resourceImagesImageAccessAcceptV2(),
-			"openstack_lb_member_v1":                             resourceLBMemberV1(),
-			"openstack_lb_monitor_v1":                            resourceLBMonitorV1(),
-			"openstack_lb_pool_v1":                               resourceLBPoolV1(),
-			"openstack_lb_vip_v1":                                resourceLBVipV1(),
-			"openstack_lb_loadbalancer_v2":                       resourceLoadBalancerV2(),
-			"openstack_lb_listener_v2":                           resourceListenerV2(),
-			"openstack_lb_pool_v2":                               resourcePoolV2(),
-			"openstack_lb_member_v2":                             resourceMemberV2(),
-			"openstack_lb_members_v2":                            resourceMembersV2(),
-			"openstack_lb_monitor_v2":                            resourceMonitorV2(),
-			"openstack_lb_l7policy_v2":                           resourceL7PolicyV2(),
-			"openstack_lb_l7rule_v2":                             resourceL7RuleV2(),
-			"openstack_lb_quota_v2":                              resourceLoadBalancerQuotaV2(),
-			"openstack_networking_floatingip_v2":                 resourceNetworkingFloatingIPV2(),
-			"openstack_networking_floatingip_associate_v2":       resourceNetworkingFloatingIPAssociateV2(),
-			"openstack_networking_network_v2":                    resourceNetworkingNetworkV2(),
-			"openstack_networking_port_v2":                       resourceNetworkingPortV2(),
-			"openstack_networking_rbac_policy_v2":                resourceNetworkingRBACPolicyV2(),
-			"openstack_networking_port_secgroup_associate_v2":    resourceNetworkingPortSecGroupAssociateV2(),
-			"openstack_networking_qos_bandwidth_limit_rule_v2":   resourceNetworkingQoSBandwidthLimitRuleV2(),
-			"openstack_networking_qos_dscp_marking_rule_v2":      resourceNetworkingQoSDSCPMarkingRuleV2(),
-			"openstack_networking_qos_minimum_bandwidth_rule_v2": resourceNetworkingQoSMinimumBandwidthRuleV2(),
-			"openstack_networking_qos_policy_v2":                 resourceNetworkingQoSPolicyV2(),
-			"openstack_networking_quota_v2":                      resourceNetworkingQuotaV2(),
-			"openstack_networking_router_v2":                     resourceNetworkingRouterV2(),
-			"openstack_networking_router_interface_v2":           resourceNetworkingRouterInterfaceV2(),
-			"openstack_networking_router_route_v2":               resourceNetworkingRouterRouteV2(),
-			"openstack_networking_secgroup_v2":                   resourceNetworkingSecGroupV2(),
-			"openstack_networking_secgroup_rule_v2":              resourceNetworkingSecGroupRuleV2(),
-			"openstack_networking_subnet_v2":                     resourceNetworkingSubnetV2(),
-			"openstack_networking_subnet_route_v2":               resourceNetworkingSubnetRouteV2(),
-			"openstack_networking_subnetpool_v2":                 resourceNetworkingSubnetPoolV2(),
-			"openstack_networking_addressscope_v2":               resourceNetworkingAddressScopeV2(),
-			"openstack_networking_trunk_v2":                      resourceNetworkingTrunkV2(),
-			"openstack_networking_portforwarding_v2":             resourceNetworkingPortForwardingV2(),
-			"openstack_objectstorage_container_v1":               resourceObjectStorageContainerV1(),
-			"openstack_objectstorage_object_v1":                  resourceObjectStorageObjectV1(),
-			"openstack_objectstorage_tempurl_v1":                 resourceObjectstorageTempurlV1(),
-			"openstack_orchestration_stack_v1":                   resourceOrchestrationStackV1(),
-			"openstack_vpnaas_ipsec_policy_v2":                   resourceIPSecPolicyV2(),
-			"openstack_vpnaas_service_v2":                        resourceServiceV2(),
-			"openstack_vpnaas_ike_policy_v2":                     resourceIKEPolicyV2(),
-			"openstack_vpnaas_endpoint_group_v2":                 resourceEndpointGroupV2(),
-			"openstack_vpnaas_site_connection_v2":                resourceSiteConnectionV2(),
-			"openstack_sharedfilesystem_securityservice_v2":      resourceSharedFilesystemSecurityServiceV2(),
-			"openstack_sharedfilesystem_sharenetwork_v2":         resourceSharedFilesystemShareNetworkV2(),
-			"openstack_sharedfilesystem_share_v2":                resourceSharedFilesystemShareV2(),
-			"openstack_sharedfilesystem_share_access_v2":         resourceSharedFilesystemShareAccessV2(),
-			"openstack_keymanager_secret_v1":                     resourceKeyManagerSecretV1(),
-			"openstack_keymanager_container_v1":                  resourceKeyManagerContainerV1(),
-			"openstack_keymanager_order_v1":                      resourceKeyManagerOrderV1(),
+			"openstack_blockstorage_qos_association_v3":            resourceBlockStorageQosAssociationV3(),
+			"openstack_blockstorage_qos_v3":                        resourceBlockStorageQosV3(),
+			"openstack_blockstorage_quotaset_v2":                   resourceBlockStorageQuotasetV2(),
+			"openstack_blockstorage_quotaset_v3":                   resourceBlockStorageQuotasetV3(),
+			"openstack_blockstorage_volume_v1":                     resourceBlockStorageVolumeV1(),
+			"openstack_blockstorage_volume_v2":                     resourceBlockStorageVolumeV2(),
+			"vtidc_blockstorage_volume_v3":                         resourceBlockStorageVolumeV3(),
+			"openstack_blockstorage_volume_attach_v2":              resourceBlockStorageVolumeAttachV2(),
+			"openstack_blockstorage_volume_attach_v3":              resourceBlockStorageVolumeAttachV3(),
+			"openstack_blockstorage_volume_type_access_v3":         resourceBlockstorageVolumeTypeAccessV3(),
+			"openstack_blockstorage_volume_type_v3":                resourceBlockStorageVolumeTypeV3(),
+			"openstack_compute_aggregate_v2":                       resourceComputeAggregateV2(),
+			"openstack_compute_flavor_v2":                          resourceComputeFlavorV2(),
+			"openstack_compute_flavor_access_v2":                   resourceComputeFlavorAccessV2(),
+			"openstack_compute_instance_v2":                        resourceComputeInstanceV2(),
+			"openstack_compute_interface_attach_v2":                resourceComputeInterfaceAttachV2(),
+			"openstack_compute_keypair_v2":                         resourceComputeKeypairV2(),
+			"openstack_compute_secgroup_v2":                        resourceComputeSecGroupV2(),
+			"openstack_compute_servergroup_v2":                     resourceComputeServerGroupV2(),
+			"openstack_compute_quotaset_v2":                        resourceComputeQuotasetV2(),
+			"openstack_compute_floatingip_v2":                      resourceComputeFloatingIPV2(),
+			"openstack_compute_floatingip_associate_v2":            resourceComputeFloatingIPAssociateV2(),
+			"openstack_compute_volume_attach_v2":                   resourceComputeVolumeAttachV2(),
+			"openstack_containerinfra_nodegroup_v1":                resourceContainerInfraNodeGroupV1(),
+			"openstack_containerinfra_clustertemplate_v1":          resourceContainerInfraClusterTemplateV1(),
+			"openstack_containerinfra_cluster_v1":                  resourceContainerInfraClusterV1(),
+			"openstack_containerinfra_cluster_ca_rotation_v1":      resourceContainerInfraClusterCARotationV1(),
+			"openstack_containerinfra_certificate_v1":              resourceContainerInfraCertificateV1(),
+			"openstack_db_instance_v1":                             resourceDatabaseInstanceV1(),
+			"openstack_db_user_v1":                                 resourceDatabaseUserV1(),
+			"openstack_db_configuration_v1":                        resourceDatabaseConfigurationV1(),
+			"openstack_db_database_v1":                             resourceDatabaseDatabaseV1(),
+			"openstack_db_backup_v1":                               resourceDatabaseBackupV1(),
+			"openstack_dns_ptr_record_v2":                          resourceDNSPTRRecordV2(),
+			"openstack_dns_recordset_v2":                           resourceDNSRecordSetV2(),
+			"openstack_dns_zone_v2":                                resourceDNSZoneV2(),
+			"openstack_dns_zone_import_v2":                         resourceDNSZoneImportV2(),
+			"openstack_dns_zone_share_v2":                          resourceDNSZoneShareV2(),
+			"openstack_dns_transfer_request_v2":                    resourceDNSTransferRequestV2(),
+			"openstack_dns_transfer_accept_v2":                     resourceDNSTransferAcceptV2(),
+			"openstack_fw_firewall_v1":                             resourceFWFirewallV1(),
+			"openstack_fw_group_v2":                                resourceFWGroupV2(),
+			"openstack_fw_policy_v1":                               resourceFWPolicyV1(),
+			"openstack_fw_policy_v2":                               resourceFWPolicyV2(),
+			"openstack_fw_rule_v1":                                 resourceFWRuleV1(),
+			"openstack_fw_rule_v2":                                 resourceFWRuleV2(),
+			"openstack_identity_endpoint_v3":                       resourceIdentityEndpointV3(),
+			"openstack_identity_project_v3":                        resourceIdentityProjectV3(),
+			"openstack_identity_role_v3":                           resourceIdentityRoleV3(),
+			"openstack_identity_role_assignment_v3":                resourceIdentityRoleAssignmentV3(),
+			"openstack_identity_inherit_role_assignment_v3":        resourceIdentityInheritRoleAssignmentV3(),
+			"openstack_identity_service_v3":                        resourceIdentityServiceV3(),
+			"openstack_identity_user_v3":                           resourceIdentityUserV3(),
+			"openstack_identity_user_membership_v3":                resourceIdentityUserMembershipV3(),
+			"openstack_identity_group_v3":                          resourceIdentityGroupV3(),
+			"openstack_identity_application_credential_v3":         resourceIdentityApplicationCredentialV3(),
+			"openstack_identity_ec2_credential_v3":                 resourceIdentityEc2CredentialV3(),
+			"openstack_images_image_v2":                            resourceImagesImageV2(),
+			"openstack_images_image_access_v2":                     resourceImagesImageAccessV2(),
+			"openstack_images_image_access_accept_v2":              resourceImagesImageAccessAcceptV2(),
+			"openstack_images_metadef_namespace_v2":                resourceImagesMetadefNamespaceV2(),
+			"openstack_images_metadef_object_v2":                   resourceImagesMetadefObjectV2(),
+			"openstack_images_metadef_property_v2":                 resourceImagesMetadefPropertyV2(),
+			"openstack_lb_member_v1":                               resourceLBMemberV1(),
+			"openstack_lb_monitor_v1":                              resourceLBMonitorV1(),
+			"openstack_lb_pool_v1":                                 resourceLBPoolV1(),
+			"openstack_lb_vip_v1":                                  resourceLBVipV1(),
+			"openstack_lb_loadbalancer_v2":                         resourceLoadBalancerV2(),
+			"openstack_lb_listener_v2":                             resourceListenerV2(),
+			"openstack_lb_pool_v2":                                 resourcePoolV2(),
+			"openstack_lb_member_v2":                               resourceMemberV2(),
+			"openstack_lb_members_v2":                              resourceMembersV2(),
+			"openstack_lb_monitor_v2":                              resourceMonitorV2(),
+			"openstack_lb_l7policy_v2":                             resourceL7PolicyV2(),
+			"openstack_lb_l7rule_v2":                               resourceL7RuleV2(),
+			"openstack_lb_quota_v2":                                resourceLoadBalancerQuotaV2(),
+			"openstack_networking_floatingip_v2":                   resourceNetworkingFloatingIPV2(),
+			"openstack_networking_floatingip_associate_v2":         resourceNetworkingFloatingIPAssociateV2(),
+			"openstack_networking_network_v2":                      resourceNetworkingNetworkV2(),
+			"openstack_networking_local_ip_v2":                     resourceNetworkingLocalIPV2(),
+			"openstack_networking_local_ip_associate_v2":           resourceNetworkingLocalIPAssociateV2(),
+			"openstack_networking_port_v2":                         resourceNetworkingPortV2(),
+			"openstack_networking_rbac_policy_v2":                  resourceNetworkingRBACPolicyV2(),
+			"openstack_networking_port_secgroup_associate_v2":      resourceNetworkingPortSecGroupAssociateV2(),
+			"openstack_networking_qos_bandwidth_limit_rule_v2":     resourceNetworkingQoSBandwidthLimitRuleV2(),
+			"openstack_networking_qos_dscp_marking_rule_v2":        resourceNetworkingQoSDSCPMarkingRuleV2(),
+			"openstack_networking_qos_minimum_bandwidth_rule_v2":   resourceNetworkingQoSMinimumBandwidthRuleV2(),
+			"openstack_networking_qos_minimum_packet_rate_rule_v2": resourceNetworkingQoSMinimumPacketRateRuleV2(),
+			"openstack_networking_qos_packet_rate_limit_rule_v2":   resourceNetworkingQoSPacketRateLimitRuleV2(),
+			"openstack_networking_qos_policy_v2":                   resourceNetworkingQoSPolicyV2(),
+			"openstack_networking_quota_v2":                        resourceNetworkingQuotaV2(),
+			"openstack_networking_router_v2":                       resourceNetworkingRouterV2(),
+			"openstack_networking_router_interface_v2":             resourceNetworkingRouterInterfaceV2(),
+			"openstack_networking_router_route_v2":                 resourceNetworkingRouterRouteV2(),
+			"openstack_networking_router_conntrack_helper_v2":      resourceNetworkingRouterConntrackHelperV2(),
+			"openstack_networking_ndp_proxy_v2":                    resourceNetworkingNDPProxyV2(),
+			"openstack_networking_secgroup_v2":                     resourceNetworkingSecGroupV2(),
+			"openstack_networking_secgroup_rule_v2":                resourceNetworkingSecGroupRuleV2(),
+			"openstack_networking_subnet_v2":                       resourceNetworkingSubnetV2(),
+			"openstack_networking_subnet_route_v2":                 resourceNetworkingSubnetRouteV2(),
+			"openstack_networking_subnetpool_v2":                   resourceNetworkingSubnetPoolV2(),
+			"openstack_networking_addressscope_v2":                 resourceNetworkingAddressScopeV2(),
+			"openstack_networking_trunk_v2":                        resourceNetworkingTrunkV2(),
+			"openstack_networking_portforwarding_v2":               resourceNetworkingPortForwardingV2(),
+			"openstack_objectstorage_account_v1":                   resourceObjectStorageAccountV1(),
+			"openstack_objectstorage_container_v1":                 resourceObjectStorageContainerV1(),
+			"openstack_objectstorage_object_v1":                    resourceObjectStorageObjectV1(),
+			"openstack_objectstorage_object_sync_v1":               resourceObjectStorageObjectSyncV1(),
+			"openstack_objectstorage_tempurl_v1":                   resourceObjectstorageTempurlV1(),
+			"openstack_orchestration_stack_v1":                     resourceOrchestrationStackV1(),
+			"openstack_vpnaas_ipsec_policy_v2":                     resourceIPSecPolicyV2(),
+			"openstack_vpnaas_service_v2":                          resourceServiceV2(),
+			"openstack_vpnaas_ike_policy_v2":                       resourceIKEPolicyV2(),
+			"openstack_vpnaas_endpoint_group_v2":                   resourceEndpointGroupV2(),
+			"openstack_vpnaas_site_connection_v2":                  resourceSiteConnectionV2(),
+			"openstack_sharedfilesystem_securityservice_v2":        resourceSharedFilesystemSecurityServiceV2(),
+			"openstack_sharedfilesystem_sharenetwork_v2":           resourceSharedFilesystemShareNetworkV2(),
+			"openstack_sharedfilesystem_share_v2":                  resourceSharedFilesystemShareV2(),
+			"openstack_sharedfilesystem_share_access_v2":           resourceSharedFilesystemShareAccessV2(),
+			"openstack_sharedfilesystem_share_replica_v2":          resourceSharedFilesystemShareReplicaV2(),
+			"openstack_sharedfilesystem_snapshot_v2":               resourceSharedFilesystemSnapshotV2(),
+			"openstack_sharedfilesystem_share_type_v2":             resourceSharedFilesystemShareTypeV2(),
+			"openstack_sharedfilesystem_share_type_access_v2":      resourceSharedFilesystemShareTypeAccessV2(),
+			"openstack_sharedfilesystem_share_group_type_v2":       resourceSharedFilesystemShareGroupTypeV2(),
+			"openstack_sharedfilesystem_share_group_v2":            resourceSharedFilesystemShareGroupV2(),
+			"openstack_sharedfilesystem_share_group_snapshot_v2":   resourceSharedFilesystemShareGroupSnapshotV2(),
+			"openstack_keymanager_secret_v1":                       resourceKeyManagerSecretV1(),
+			"openstack_keymanager_container_v1":                    resourceKeyManagerContainerV1(),
+			"openstack_keymanager_order_v1":                        resourceKeyManagerOrderV1(),
 		},
 	}
 
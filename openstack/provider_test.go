@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,6 +43,7 @@ var (
 	osTransparentVlanEnvironment = os.Getenv("OS_TRANSPARENT_VLAN_ENVIRONMENT")
 	osKeymanagerEnvironment      = os.Getenv("OS_KEYMANAGER_ENVIRONMENT")
 	osGlanceimportEnvironment    = os.Getenv("OS_GLANCEIMPORT_ENVIRONMENT")
+	osGlanceStores               = os.Getenv("OS_GLANCE_STORES")
 	osHypervisorEnvironment      = os.Getenv("OS_HYPERVISOR_HOSTNAME")
 	osPortForwardingEnvironment  = os.Getenv("OS_PORT_FORWARDING_ENVIRONMENT")
 	osBlockStorageV2             = os.Getenv("OS_BLOCKSTORAGE_V2")
@@ -255,6 +257,12 @@ func testAccPreCheckGlanceImport(t *testing.T) {
 	}
 }
 
+func testAccPreCheckGlanceStores(t *testing.T) {
+	if len(strings.Split(osGlanceStores, ",")) < 2 {
+		t.Skip("OS_GLANCE_STORES must be set to at least two Glance stores for acceptance tests")
+	}
+}
+
 func testAccPreCheckHypervisor(t *testing.T) {
 	if osHypervisorEnvironment == "" {
 		t.Skip("This environment does not support Hypervisor data source tests")
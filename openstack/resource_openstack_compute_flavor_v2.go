@@ -20,6 +20,8 @@ func resourceComputeFlavorV2() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: imagesMetadefV2CustomizeDiffValidate("extra_specs", "validate_extra_specs", "OS::Nova::Flavor"),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -94,6 +96,12 @@ func resourceComputeFlavorV2() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"validate_extra_specs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -97,6 +98,38 @@ func TestAccComputeV2Flavor_extraSpecs(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Flavor_validateExtraSpecs(t *testing.T) {
+	var flavor flavors.Flavor
+	var flavorName = acctest.RandomWithPrefix("tf-acc-flavor")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckComputeV2FlavorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputeV2FlavorValidateExtraSpecs(flavorName, "hw:cpu_policy", "CPU-POLICY"),
+				ExpectError: regexp.MustCompile(`"hw:cpu_policy": must be one of`),
+			},
+			{
+				Config:      testAccComputeV2FlavorValidateExtraSpecs(flavorName, "hw:cpu_polcy", "dedicated"),
+				ExpectError: regexp.MustCompile(`did you mean "hw:cpu_policy"`),
+			},
+			{
+				Config: testAccComputeV2FlavorValidateExtraSpecs(flavorName, "hw:cpu_policy", "dedicated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2FlavorExists("openstack_compute_flavor_v2.flavor_1", &flavor),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_flavor_v2.flavor_1", "extra_specs.hw:cpu_policy", "dedicated"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeV2FlavorDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	computeClient, err := config.ComputeV2Client(osRegionName)
@@ -213,3 +246,20 @@ func testAccComputeV2FlavorExtraSpecs2(flavorName string) string {
     }
     `, flavorName)
 }
+
+func testAccComputeV2FlavorValidateExtraSpecs(flavorName, key, value string) string {
+	return fmt.Sprintf(`
+    resource "openstack_compute_flavor_v2" "flavor_1" {
+      name = "%s"
+      ram = 2048
+      vcpus = 2
+      disk = 5
+
+      validate_extra_specs = true
+
+      extra_specs = {
+        "%s" = "%s"
+      }
+    }
+    `, flavorName, key, value)
+}
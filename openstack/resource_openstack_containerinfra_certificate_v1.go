@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/certificates"
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clusters"
+)
+
+func resourceContainerInfraCertificateV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceContainerInfraCertificateV1Create,
+		ReadContext:   resourceContainerInfraCertificateV1Read,
+		DeleteContext: resourceContainerInfraCertificateV1Delete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"csr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"cluster_uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"pem": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ca_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceContainerInfraCertificateV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	cluster, err := clusters.Get(containerInfraClient, d.Get("cluster_id").(string)).Extract()
+	if err != nil {
+		return diag.Errorf("Error getting openstack_containerinfra_cluster_v1 %s: %s", d.Get("cluster_id").(string), err)
+	}
+
+	createOpts := certificates.CreateOpts{
+		ClusterUUID: cluster.UUID,
+		CSR:         d.Get("csr").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_containerinfra_certificate_v1 create options: %#v", createOpts)
+
+	certificate, err := certificates.Create(containerInfraClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("Error creating openstack_containerinfra_certificate_v1: %s", err)
+	}
+
+	fingerprint, err := containerInfraV1CertificateFingerprint(certificate.PEM)
+	if err != nil {
+		return diag.Errorf("Error parsing openstack_containerinfra_certificate_v1: %s", err)
+	}
+
+	// The signed certificate can't be retrieved again, so its
+	// fingerprint is used as the ID.
+	d.SetId(fingerprint)
+	d.Set("cluster_uuid", cluster.UUID)
+	d.Set("pem", certificate.PEM)
+
+	return resourceContainerInfraCertificateV1Read(ctx, d, meta)
+}
+
+func resourceContainerInfraCertificateV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	certificateAuthority, err := certificates.Get(containerInfraClient, d.Get("cluster_uuid").(string)).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_containerinfra_certificate_v1"))
+	}
+
+	fingerprint, err := containerInfraV1CertificateFingerprint(certificateAuthority.PEM)
+	if err != nil {
+		return diag.Errorf("Error parsing certificate authority of openstack_containerinfra_cluster_v1 %s: %s", d.Get("cluster_uuid").(string), err)
+	}
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("ca_certificate", certificateAuthority.PEM)
+	d.Set("ca_fingerprint", fingerprint)
+
+	return nil
+}
+
+func resourceContainerInfraCertificateV1Delete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Magnum can't revoke a signed certificate, so it is only removed
+	// from the state.
+	log.Printf("[DEBUG] Removing openstack_containerinfra_certificate_v1 %s from the state", d.Id())
+
+	return nil
+}
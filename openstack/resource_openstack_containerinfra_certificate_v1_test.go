@@ -0,0 +1,87 @@
+package openstack
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccContainerInfraV1Certificate_basic(t *testing.T) {
+	resourceName := "openstack_containerinfra_certificate_v1.certificate_1"
+	clusterName := acctest.RandomWithPrefix("tf-acc-cluster")
+	keypairName := acctest.RandomWithPrefix("tf-acc-keypair")
+	clusterTemplateName := acctest.RandomWithPrefix("tf-acc-clustertemplate")
+
+	csr, err := testAccContainerInfraV1CertificateCSR("ci-runner", "ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckContainerInfraV1ClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerInfraV1CertificateBasic(
+					testAccContainerInfraV1ClusterBasic(keypairName, clusterTemplateName, clusterName, 1), csr),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_uuid",
+						"openstack_containerinfra_cluster_v1.cluster_1", "id"),
+					resource.TestMatchResourceAttr(resourceName, "pem", regexp.MustCompile(`BEGIN CERTIFICATE`)),
+					resource.TestMatchResourceAttr(resourceName, "ca_certificate", regexp.MustCompile(`BEGIN CERTIFICATE`)),
+					resource.TestMatchResourceAttr(resourceName, "ca_fingerprint", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainerInfraV1CertificateCSR(commonName, organization string) (string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", err
+	}
+
+	csrTemplate := x509.CertificateRequest{
+		SignatureAlgorithm: x509.SHA256WithRSA,
+		Subject: pkix.Name{
+			CommonName:   commonName,
+			Organization: []string{organization},
+		},
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &csrTemplate, key)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  certificateRequestBlockType,
+		Bytes: csr,
+	})), nil
+}
+
+func testAccContainerInfraV1CertificateBasic(clusterResource, csr string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_containerinfra_certificate_v1" "certificate_1" {
+  cluster_id = "${openstack_containerinfra_cluster_v1.cluster_1.id}"
+  csr        = <<EOT
+%sEOT
+}
+`, clusterResource, csr)
+}
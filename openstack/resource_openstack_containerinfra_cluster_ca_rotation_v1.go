@@ -0,0 +1,129 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/certificates"
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clusters"
+)
+
+func resourceContainerInfraClusterCARotationV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceContainerInfraClusterCARotationV1Create,
+		ReadContext:   resourceContainerInfraClusterCARotationV1Read,
+		DeleteContext: resourceContainerInfraClusterCARotationV1Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ca_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceContainerInfraClusterCARotationV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	cluster, err := clusters.Get(containerInfraClient, d.Get("cluster_id").(string)).Extract()
+	if err != nil {
+		return diag.Errorf("Error getting openstack_containerinfra_cluster_v1 %s: %s", d.Get("cluster_id").(string), err)
+	}
+
+	log.Printf("[DEBUG] Rotating the certificate authority of openstack_containerinfra_cluster_v1 %s", cluster.UUID)
+
+	err = certificates.Update(containerInfraClient, cluster.UUID).ExtractErr()
+	if err != nil {
+		return diag.Errorf("Error rotating the certificate authority of openstack_containerinfra_cluster_v1 %s: %s", cluster.UUID, err)
+	}
+
+	d.SetId(cluster.UUID)
+
+	// Depending on the driver, the rotation is either done synchronously
+	// or through a cluster update.
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"UPDATE_IN_PROGRESS"},
+		Target:       []string{"CREATE_COMPLETE", "UPDATE_COMPLETE"},
+		Refresh:      containerInfraClusterV1StateRefreshFunc(containerInfraClient, cluster.UUID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        30 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_containerinfra_cluster_v1 %s to rotate the certificate authority: %s", cluster.UUID, err)
+	}
+
+	return resourceContainerInfraClusterCARotationV1Read(ctx, d, meta)
+}
+
+func resourceContainerInfraClusterCARotationV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	certificateAuthority, err := certificates.Get(containerInfraClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_containerinfra_cluster_ca_rotation_v1"))
+	}
+
+	fingerprint, err := containerInfraV1CertificateFingerprint(certificateAuthority.PEM)
+	if err != nil {
+		return diag.Errorf("Error parsing certificate authority of openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
+	}
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("ca_certificate", certificateAuthority.PEM)
+	d.Set("ca_fingerprint", fingerprint)
+
+	return nil
+}
+
+func resourceContainerInfraClusterCARotationV1Delete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// A rotation can't be undone, so it is only removed from the state.
+	log.Printf("[DEBUG] Removing openstack_containerinfra_cluster_ca_rotation_v1 %s from the state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccContainerInfraV1ClusterCARotation_basic(t *testing.T) {
+	resourceName := "openstack_containerinfra_cluster_ca_rotation_v1.rotation_1"
+	clusterName := acctest.RandomWithPrefix("tf-acc-cluster")
+	keypairName := acctest.RandomWithPrefix("tf-acc-keypair")
+	clusterTemplateName := acctest.RandomWithPrefix("tf-acc-clustertemplate")
+
+	var fingerprint string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckContainerInfraV1ClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerInfraV1ClusterCARotationBasic(
+					testAccContainerInfraV1ClusterBasic(keypairName, clusterTemplateName, clusterName, 1), "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_containerinfra_cluster_v1.cluster_1", "id"),
+					resource.TestMatchResourceAttr(resourceName, "ca_fingerprint", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					testAccCheckContainerInfraV1ClusterCARotationFingerprint(resourceName, &fingerprint, false),
+				),
+			},
+			{
+				Config: testAccContainerInfraV1ClusterCARotationBasic(
+					testAccContainerInfraV1ClusterBasic(keypairName, clusterTemplateName, clusterName, 1), "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraV1ClusterCARotationFingerprint(resourceName, &fingerprint, true),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckContainerInfraV1ClusterCARotationFingerprint stores the CA
+// fingerprint and, if changed is set, checks that it differs from the
+// previously stored one.
+func testAccCheckContainerInfraV1ClusterCARotationFingerprint(n string, fingerprint *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		current := rs.Primary.Attributes["ca_fingerprint"]
+		if changed && current == *fingerprint {
+			return fmt.Errorf("Certificate authority of %s wasn't rotated", rs.Primary.ID)
+		}
+
+		*fingerprint = current
+
+		return nil
+	}
+}
+
+func testAccContainerInfraV1ClusterCARotationBasic(clusterResource, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_containerinfra_cluster_ca_rotation_v1" "rotation_1" {
+  cluster_id = "${openstack_containerinfra_cluster_v1.cluster_1.id}"
+
+  triggers = {
+    rotation = "%s"
+  }
+}
+`, clusterResource, trigger)
+}
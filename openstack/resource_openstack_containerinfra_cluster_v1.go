@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clusters"
@@ -165,6 +166,18 @@ func resourceContainerInfraClusterV1() *schema.Resource {
 				Default:  1,
 			},
 
+			"nodes_to_remove": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"max_batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
 			"master_addresses": {
 				Type:     schema.TypeList,
 				ForceNew: false,
@@ -423,61 +436,73 @@ func resourceContainerInfraClusterV1Update(ctx context.Context, d *schema.Resour
 			ClusterTemplate: clusterTemplateID,
 		}
 
+		if v, ok := d.GetOk("max_batch_size"); ok {
+			maxBatchSize := v.(int)
+			upgradeOpts.MaxBatchSize = &maxBatchSize
+		}
+
+		log.Printf(
+			"[DEBUG] Upgrading openstack_containerinfra_cluster_v1 %s with options: %#v", d.Id(), upgradeOpts)
+
 		containerInfraClient.Microversion = containerInfraV1ClusterUpgradeMinMicroversion
 		_, err = clusters.Upgrade(containerInfraClient, d.Id(), upgradeOpts).Extract()
 		if err != nil {
 			return diag.Errorf("Error upgrading openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
 		}
 
-		stateConf := &resource.StateChangeConf{
-			Pending:      []string{"UPDATE_IN_PROGRESS"},
-			Target:       []string{"UPDATE_COMPLETE"},
-			Refresh:      containerInfraClusterV1StateRefreshFunc(containerInfraClient, d.Id()),
-			Timeout:      d.Timeout(schema.TimeoutUpdate),
-			Delay:        1 * time.Minute,
-			PollInterval: 20 * time.Second,
-		}
-		_, err = stateConf.WaitForStateContext(ctx)
+		err = containerInfraV1WaitForUpdate(ctx, containerInfraClusterV1StateRefreshFunc(containerInfraClient, d.Id()), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
+			// Keep the previous cluster template in the state.
+			d.Partial(true)
 			return diag.Errorf(
 				"Error waiting for openstack_containerinfra_cluster_v1 %s to upgrade: %s", d.Id(), err)
 		}
 	}
 
-	updateOpts := []clusters.UpdateOptsBuilder{}
-
 	if d.HasChange("node_count") {
 		nodeCount := d.Get("node_count").(int)
-		updateOpts = append(updateOpts, clusters.UpdateOpts{
-			Op:    clusters.ReplaceOp,
-			Path:  strings.Join([]string{"/", "node_count"}, ""),
-			Value: nodeCount,
-		})
-	}
-
-	if len(updateOpts) > 0 {
-		log.Printf(
-			"[DEBUG] Updating openstack_containerinfra_cluster_v1 %s with options: %#v", d.Id(), updateOpts)
-
-		_, err = clusters.Update(containerInfraClient, d.Id(), updateOpts).Extract()
-		if err != nil {
-			return diag.Errorf("Error updating openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
+		nodesToRemove := containerInfraV1NodesToRemove(d)
+
+		if len(nodesToRemove) > 0 {
+			resizeOpts := clusters.ResizeOpts{
+				NodeCount:     &nodeCount,
+				NodesToRemove: nodesToRemove,
+			}
+
+			log.Printf(
+				"[DEBUG] Resizing openstack_containerinfra_cluster_v1 %s with options: %#v", d.Id(), resizeOpts)
+
+			containerInfraClient.Microversion = containerInfraV1ClusterResizeMinMicroversion
+			_, err = clusters.Resize(containerInfraClient, d.Id(), resizeOpts).Extract()
+			if err != nil {
+				return diag.Errorf("Error resizing openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
+			}
+		} else {
+			updateOpts := []clusters.UpdateOptsBuilder{
+				clusters.UpdateOpts{
+					Op:    clusters.ReplaceOp,
+					Path:  strings.Join([]string{"/", "node_count"}, ""),
+					Value: nodeCount,
+				},
+			}
+
+			log.Printf(
+				"[DEBUG] Updating openstack_containerinfra_cluster_v1 %s with options: %#v", d.Id(), updateOpts)
+
+			_, err = clusters.Update(containerInfraClient, d.Id(), updateOpts).Extract()
+			if err != nil {
+				return diag.Errorf("Error updating openstack_containerinfra_cluster_v1 %s: %s", d.Id(), err)
+			}
 		}
 
-		stateConf := &resource.StateChangeConf{
-			Pending:      []string{"UPDATE_IN_PROGRESS"},
-			Target:       []string{"UPDATE_COMPLETE"},
-			Refresh:      containerInfraClusterV1StateRefreshFunc(containerInfraClient, d.Id()),
-			Timeout:      d.Timeout(schema.TimeoutUpdate),
-			Delay:        1 * time.Minute,
-			PollInterval: 20 * time.Second,
-		}
-		_, err = stateConf.WaitForStateContext(ctx)
+		err = containerInfraV1WaitForUpdate(ctx, containerInfraClusterV1StateRefreshFunc(containerInfraClient, d.Id()), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
+			d.Partial(true)
 			return diag.Errorf(
 				"Error waiting for openstack_containerinfra_cluster_v1 %s to become updated: %s", d.Id(), err)
 		}
 	}
+
 	return resourceContainerInfraClusterV1Read(ctx, d, meta)
 }
 
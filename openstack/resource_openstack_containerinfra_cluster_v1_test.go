@@ -283,6 +283,44 @@ func TestAccContainerInfraV1Cluster_overrideLabels(t *testing.T) {
 	})
 }
 
+func TestAccContainerInfraV1Cluster_upgrade(t *testing.T) {
+	var cluster clusters.Cluster
+
+	resourceName := "openstack_containerinfra_cluster_v1.cluster_1"
+	clusterName := acctest.RandomWithPrefix("tf-acc-cluster")
+	keypairName := acctest.RandomWithPrefix("tf-acc-keypair")
+	clusterTemplateName := acctest.RandomWithPrefix("tf-acc-clustertemplate")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckContainerInfraV1ClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerInfraV1ClusterUpgrade(keypairName, clusterTemplateName, clusterName, "clustertemplate_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraV1ClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_template_id",
+						"openstack_containerinfra_clustertemplate_v1.clustertemplate_1", "id"),
+				),
+			},
+			{
+				Config: testAccContainerInfraV1ClusterUpgrade(keypairName, clusterTemplateName, clusterName, "clustertemplate_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraV1ClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &cluster.UUID),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_template_id",
+						"openstack_containerinfra_clustertemplate_v1.clustertemplate_2", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckContainerInfraV1ClusterExists(n string, cluster *clusters.Cluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -423,3 +461,64 @@ resource "openstack_containerinfra_cluster_v1" "cluster_1" {
 }
 `, keypairName, clusterTemplateName, osMagnumImage, osExtGwID, osMagnumHTTPProxy, osMagnumHTTPSProxy, osMagnumNoProxy, osRegionName, clusterName, osMagnumFlavor, osMagnumFlavor, nodeCount, mergeLabels, osMagnumLabels)
 }
+
+func testAccContainerInfraV1ClusterUpgrade(keypairName, clusterTemplateName, clusterName, clusterTemplate string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_keypair_v2" "keypair_1" {
+  name = "%[1]s"
+}
+
+resource "openstack_containerinfra_clustertemplate_v1" "clustertemplate_1" {
+  name                  = "%[2]s-1"
+  image                 = "%[3]s"
+  coe                   = "kubernetes"
+  floating_ip_enabled   = false
+  volume_driver         = "cinder"
+  docker_storage_driver = "overlay2"
+  docker_volume_size    = 5
+  external_network_id   = "%[4]s"
+  network_driver        = "flannel"
+  http_proxy            = "%[5]s"
+  https_proxy           = "%[6]s"
+  no_proxy              = "%[7]s"
+  labels = {
+    kube_dashboard_enabled = "true",
+	%[8]s
+  }
+}
+
+resource "openstack_containerinfra_clustertemplate_v1" "clustertemplate_2" {
+  name                  = "%[2]s-2"
+  image                 = "%[3]s"
+  coe                   = "kubernetes"
+  floating_ip_enabled   = false
+  volume_driver         = "cinder"
+  docker_storage_driver = "overlay2"
+  docker_volume_size    = 5
+  external_network_id   = "%[4]s"
+  network_driver        = "flannel"
+  http_proxy            = "%[5]s"
+  https_proxy           = "%[6]s"
+  no_proxy              = "%[7]s"
+  labels = {
+    kube_dashboard_enabled = "false",
+	%[8]s
+  }
+}
+
+resource "openstack_containerinfra_cluster_v1" "cluster_1" {
+  region              = "%[9]s"
+  name                = "%[10]s"
+  cluster_template_id = "${openstack_containerinfra_clustertemplate_v1.%[12]s.id}"
+  create_timeout      = "40"
+  docker_volume_size  = "10"
+  flavor              = "%[11]s"
+  master_flavor       = "%[11]s"
+  keypair             = "${openstack_compute_keypair_v2.keypair_1.name}"
+  master_count        = 1
+  node_count          = 2
+  max_batch_size      = 1
+  floating_ip_enabled = true
+}
+`, keypairName, clusterTemplateName, osMagnumImage, osExtGwID, osMagnumHTTPProxy, osMagnumHTTPSProxy, osMagnumNoProxy, osMagnumLabels, osRegionName, clusterName, osMagnumFlavor, clusterTemplate)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clusters"
 	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/nodegroups"
@@ -101,6 +102,24 @@ func resourceContainerInfraNodeGroupV1() *schema.Resource {
 				Default:  1,
 			},
 
+			"nodes_to_remove": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"cluster_template_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"max_batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
 			"min_node_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -253,6 +272,18 @@ func resourceContainerInfraNodeGroupV1Read(_ context.Context, d *schema.Resource
 	d.Set("flavor_id", nodeGroup.FlavorID)
 	d.Set("docker_volume_size", nodeGroup.DockerVolumeSize)
 
+	// Magnum doesn't report the template of a node group. A node group is
+	// created with the template of its cluster and can only be upgraded to
+	// it, so the template of the cluster is used, when it is not known yet,
+	// e.g. on import.
+	if d.Get("cluster_template_id").(string) == "" {
+		cluster, err := clusters.Get(containerInfraClient, clusterID).Extract()
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_containerinfra_cluster_v1 %s of openstack_containerinfra_nodegroup_v1 %s: %s", clusterID, d.Id(), err)
+		}
+		d.Set("cluster_template_id", cluster.ClusterTemplateID)
+	}
+
 	if err := d.Set("created_at", nodeGroup.CreatedAt.Format(time.RFC3339)); err != nil {
 		log.Printf("[DEBUG] Unable to set openstack_containerinfra_nodegroup_v1 created_at: %s", err)
 	}
@@ -301,27 +332,55 @@ func resourceContainerInfraNodeGroupV1Update(ctx context.Context, d *schema.Reso
 		}
 	}
 
+	// The node group is created with the template of the cluster, so
+	// an upgrade is only issued, if the template is changed later.
+	if d.HasChange("cluster_template_id") && d.Get("cluster_template_id").(string) != "" {
+		upgradeOpts := clusters.UpgradeOpts{
+			ClusterTemplate: d.Get("cluster_template_id").(string),
+			NodeGroup:       nodeGroupID,
+		}
+
+		if v, ok := d.GetOk("max_batch_size"); ok {
+			maxBatchSize := v.(int)
+			upgradeOpts.MaxBatchSize = &maxBatchSize
+		}
+
+		log.Printf(
+			"[DEBUG] Upgrading openstack_containerinfra_nodegroup_v1 %s with options: %#v", d.Id(), upgradeOpts)
+
+		_, err = clusters.Upgrade(containerInfraClient, clusterID, upgradeOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error upgrading openstack_containerinfra_nodegroup_v1 %s: %s", d.Id(), err)
+		}
+
+		err = containerInfraV1WaitForUpdate(ctx, containerInfraNodeGroupV1StateRefreshFunc(containerInfraClient, clusterID, nodeGroupID), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			// Keep the previous cluster template in the state.
+			d.Partial(true)
+			return diag.Errorf(
+				"Error waiting for openstack_containerinfra_nodegroup_v1 %s to upgrade: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("node_count") {
 		v := d.Get("node_count").(int)
 		var resizeOpts = clusters.ResizeOpts{
-			NodeCount: &v,
-			NodeGroup: nodeGroupID,
+			NodeCount:     &v,
+			NodeGroup:     nodeGroupID,
+			NodesToRemove: containerInfraV1NodesToRemove(d),
 		}
+
+		log.Printf(
+			"[DEBUG] Resizing openstack_containerinfra_nodegroup_v1 %s with options: %#v", d.Id(), resizeOpts)
+
 		_, err = clusters.Resize(containerInfraClient, clusterID, resizeOpts).Extract()
 		if err != nil {
 			return diag.Errorf("Error resizing openstack_containerinfra_nodegroup_v1 %s: %s", d.Id(), err)
 		}
 
-		stateConf := &resource.StateChangeConf{
-			Pending:      []string{"UPDATE_IN_PROGRESS"},
-			Target:       []string{"UPDATE_COMPLETE"},
-			Refresh:      containerInfraNodeGroupV1StateRefreshFunc(containerInfraClient, clusterID, nodeGroupID),
-			Timeout:      d.Timeout(schema.TimeoutUpdate),
-			Delay:        1 * time.Minute,
-			PollInterval: 20 * time.Second,
-		}
-		_, err = stateConf.WaitForStateContext(ctx)
+		err = containerInfraV1WaitForUpdate(ctx, containerInfraNodeGroupV1StateRefreshFunc(containerInfraClient, clusterID, nodeGroupID), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
+			d.Partial(true)
 			return diag.Errorf(
 				"Error waiting for openstack_containerinfra_node_group_v1 %s to become updated: %s", d.Id(), err)
 		}
@@ -0,0 +1,160 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDatabaseV1Backup_basic(t *testing.T) {
+	var backup databaseBackupV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1BackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1BackupBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1BackupExists(
+						"openstack_db_backup_v1.backup_1", &backup),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_db_backup_v1.backup_1", "name", &backup.Name),
+					resource.TestCheckResourceAttr(
+						"openstack_db_backup_v1.backup_1", "status", "COMPLETED"),
+					resource.TestCheckResourceAttr(
+						"openstack_db_backup_v1.backup_1", "description", "terraform acceptance test"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_db_backup_v1.backup_1", "instance_id",
+						"openstack_db_instance_v1.basic", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_db_backup_v1.backup_1", "datastore.0.type", osDBDatastoreType),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseV1Backup_incremental(t *testing.T) {
+	var backup databaseBackupV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1BackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1BackupIncremental(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1BackupExists(
+						"openstack_db_backup_v1.backup_2", &backup),
+					resource.TestCheckResourceAttrPair(
+						"openstack_db_backup_v1.backup_2", "parent_id",
+						"openstack_db_backup_v1.backup_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatabaseV1BackupExists(n string, backup *databaseBackupV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		DatabaseV1Client, err := config.DatabaseV1Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack database client: %s", err)
+		}
+
+		found, err := databaseBackupV1Get(DatabaseV1Client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Backup not found")
+		}
+
+		*backup = *found
+
+		return nil
+	}
+}
+
+func testAccCheckDatabaseV1BackupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	DatabaseV1Client, err := config.DatabaseV1Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack database client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_db_backup_v1" {
+			continue
+		}
+
+		_, err := databaseBackupV1Get(DatabaseV1Client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Backup still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccDatabaseV1BackupBasic() string {
+	return fmt.Sprintf(`
+resource "openstack_db_instance_v1" "basic" {
+  name = "basic"
+
+  datastore {
+    version = "%s"
+    type    = "%s"
+  }
+
+  network {
+    uuid = "%s"
+  }
+
+  size = 10
+}
+
+resource "openstack_db_backup_v1" "backup_1" {
+  name        = "backup_1"
+  description = "terraform acceptance test"
+  instance_id = "${openstack_db_instance_v1.basic.id}"
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID)
+}
+
+func testAccDatabaseV1BackupIncremental() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_db_backup_v1" "backup_2" {
+  name        = "backup_2"
+  instance_id = "${openstack_db_instance_v1.basic.id}"
+  parent_id   = "${openstack_db_backup_v1.backup_1.id}"
+}
+`, testAccDatabaseV1BackupBasic())
+}
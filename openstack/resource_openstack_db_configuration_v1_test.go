@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -39,6 +40,28 @@ func TestAccDatabaseV1Configuration_basic(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseV1Configuration_invalidValue(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1ConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDatabaseV1ConfigurationInvalidName(),
+				ExpectError: regexp.MustCompile(`max_connection is not a configuration parameter`),
+			},
+			{
+				Config:      testAccDatabaseV1ConfigurationInvalidType(),
+				ExpectError: regexp.MustCompile(`max_connections is of type integer`),
+			},
+		},
+	})
+}
+
 func testAccCheckDatabaseV1ConfigurationExists(n string, configuration *configurations.Config) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -121,3 +144,41 @@ resource "openstack_db_configuration_v1" "basic" {
 }
 `, osDBDatastoreVersion, osDBDatastoreType)
 }
+
+func testAccDatabaseV1ConfigurationInvalidName() string {
+	return fmt.Sprintf(`
+resource "openstack_db_configuration_v1" "basic" {
+  name        = "basic"
+  description = "test"
+
+  datastore {
+    version = "%s"
+    type    = "%s"
+  }
+
+  configuration {
+    name  = "max_connection"
+    value = 200
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType)
+}
+
+func testAccDatabaseV1ConfigurationInvalidType() string {
+	return fmt.Sprintf(`
+resource "openstack_db_configuration_v1" "basic" {
+  name        = "basic"
+  description = "test"
+
+  datastore {
+    version = "%s"
+    type    = "%s"
+  }
+
+  configuration {
+    name  = "max_connections"
+    value = "many"
+  }
+}
+`, osDBDatastoreVersion, osDBDatastoreType)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -23,9 +24,15 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.ForceNewIfChange("size", func(ctx context.Context, old, new, meta interface{}) bool {
+			// Trove only supports to increase the volume size.
+			return new.(int) < old.(int)
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -42,7 +49,6 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 			"flavor_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Computed:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_FLAVOR_ID", nil),
 			},
@@ -50,7 +56,20 @@ func resourceDatabaseInstanceV1() *schema.Resource {
 			"size": {
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
+			},
+
+			"backup_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"replica_of"},
+			},
+
+			"replica_of": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"backup_id"},
 			},
 
 			"datastore": {
@@ -188,10 +207,14 @@ func resourceDatabaseInstanceV1Create(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("Error creating OpenStack database client: %s", err)
 	}
 
-	createOpts := &instances.CreateOpts{
-		FlavorRef: d.Get("flavor_id").(string),
-		Name:      d.Get("name").(string),
-		Size:      d.Get("size").(int),
+	createOpts := &DatabaseInstanceV1CreateOpts{
+		CreateOpts: instances.CreateOpts{
+			FlavorRef: d.Get("flavor_id").(string),
+			Name:      d.Get("name").(string),
+			Size:      d.Get("size").(int),
+		},
+		RestorePoint: d.Get("backup_id").(string),
+		ReplicaOf:    d.Get("replica_of").(string),
 	}
 
 	// datastore
@@ -230,18 +253,7 @@ func resourceDatabaseInstanceV1Create(ctx context.Context, d *schema.ResourceDat
 	}
 
 	// Wait for the instance to become available.
-	log.Printf("[DEBUG] Waiting for openstack_db_instance_v1 %s to become available", instance.ID)
-
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"BUILD"},
-		Target:     []string{"ACTIVE", "HEALTHY"},
-		Refresh:    databaseInstanceV1StateRefreshFunc(DatabaseV1Client, instance.ID),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForStateContext(ctx)
+	err = databaseInstanceV1WaitForActive(ctx, DatabaseV1Client, instance.ID, []string{"BUILD"}, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.Errorf("Error waiting for openstack_db_instance_v1 %s to become ready: %s", instance.ID, err)
 	}
@@ -275,8 +287,18 @@ func resourceDatabaseInstanceV1Read(_ context.Context, d *schema.ResourceData, m
 
 	log.Printf("[DEBUG] Retrieved openstack_db_instance_v1 %s: %#v", d.Id(), instance)
 
+	replicaOf, err := databaseInstanceV1GetReplicaOf(DatabaseV1Client, d.Id())
+	if err != nil {
+		return diag.Errorf("Error retrieving replica_of of openstack_db_instance_v1 %s: %s", d.Id(), err)
+	}
+
 	d.Set("name", instance.Name)
-	d.Set("flavor_id", instance.Flavor)
+	d.Set("flavor_id", instance.Flavor.ID)
+	// Instances of volume-less flavors don't report a volume size.
+	if instance.Volume.Size > 0 {
+		d.Set("size", instance.Volume.Size)
+	}
+	d.Set("replica_of", replicaOf)
 	d.Set("datastore", instance.Datastore)
 	d.Set("region", GetRegion(d, config))
 	d.Set("addresses", instance.IP)
@@ -291,6 +313,34 @@ func resourceDatabaseInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error creating OpenStack database client: %s", err)
 	}
 
+	if d.HasChange("flavor_id") {
+		flavorID := d.Get("flavor_id").(string)
+		log.Printf("[DEBUG] Resizing openstack_db_instance_v1 %s to flavor %s", d.Id(), flavorID)
+		err := instances.Resize(DatabaseV1Client, d.Id(), flavorID).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error resizing openstack_db_instance_v1 %s to flavor %s: %s", d.Id(), flavorID, err)
+		}
+
+		err = databaseInstanceV1WaitForActive(ctx, DatabaseV1Client, d.Id(), []string{"RESIZE"}, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_db_instance_v1 %s to be resized: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("size") {
+		size := d.Get("size").(int)
+		log.Printf("[DEBUG] Resizing openstack_db_instance_v1 %s volume to %d GB", d.Id(), size)
+		err := instances.ResizeVolume(DatabaseV1Client, d.Id(), size).ExtractErr()
+		if err != nil {
+			return diag.Errorf("Error resizing openstack_db_instance_v1 %s volume to %d GB: %s", d.Id(), size, err)
+		}
+
+		err = databaseInstanceV1WaitForActive(ctx, DatabaseV1Client, d.Id(), []string{"RESIZE"}, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_db_instance_v1 %s volume to be resized: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("configuration_id") {
 		o, n := d.GetChange("configuration_id")
 
@@ -57,6 +57,95 @@ func TestAccDatabaseV1Instance_basic(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseV1Instance_resize(t *testing.T) {
+	var instance instances.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1InstanceResize(10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.basic", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.basic", "size", "10"),
+				),
+			},
+			{
+				Config: testAccDatabaseV1InstanceResize(11),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_db_instance_v1.basic", "id", &instance.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_db_instance_v1.basic", "size", "11"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseV1Instance_replica(t *testing.T) {
+	var instance, replica instances.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1InstanceReplica(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.basic", &instance),
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.replica", &replica),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_db_instance_v1.replica", "replica_of", &instance.ID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseV1Instance_restore(t *testing.T) {
+	var instance, restored instances.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDatabase(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDatabaseV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseV1InstanceRestore(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.basic", &instance),
+					testAccCheckDatabaseV1InstanceExists(
+						"openstack_db_instance_v1.restored", &restored),
+					resource.TestCheckResourceAttrPair(
+						"openstack_db_instance_v1.restored", "backup_id",
+						"openstack_db_backup_v1.backup_1", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDatabaseV1InstanceExists(n string, instance *instances.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -175,3 +264,97 @@ resource "openstack_db_configuration_v1" "basic" {
 }
 `, osDBDatastoreVersion, osDBDatastoreType, osNetworkID)
 }
+
+func testAccDatabaseV1InstanceResize(size int) string {
+	return fmt.Sprintf(`
+resource "openstack_db_instance_v1" "basic" {
+  name = "basic"
+
+  datastore {
+    version = "%s"
+    type    = "%s"
+  }
+
+  network {
+    uuid = "%s"
+  }
+
+  size = %d
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID, size)
+}
+
+func testAccDatabaseV1InstanceReplica() string {
+	return fmt.Sprintf(`
+resource "openstack_db_instance_v1" "basic" {
+  name = "basic"
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+
+  size = 10
+}
+
+resource "openstack_db_instance_v1" "replica" {
+  name       = "replica"
+  replica_of = "${openstack_db_instance_v1.basic.id}"
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+
+  size = 10
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID)
+}
+
+func testAccDatabaseV1InstanceRestore() string {
+	return fmt.Sprintf(`
+resource "openstack_db_instance_v1" "basic" {
+  name = "basic"
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+
+  size = 10
+}
+
+resource "openstack_db_backup_v1" "backup_1" {
+  name        = "backup_1"
+  instance_id = "${openstack_db_instance_v1.basic.id}"
+}
+
+resource "openstack_db_instance_v1" "restored" {
+  name      = "restored"
+  backup_id = "${openstack_db_backup_v1.backup_1.id}"
+
+  datastore {
+    version = "%[1]s"
+    type    = "%[2]s"
+  }
+
+  network {
+    uuid = "%[3]s"
+  }
+
+  size = 10
+}
+`, osDBDatastoreVersion, osDBDatastoreType, osNetworkID)
+}
@@ -0,0 +1,232 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDNSPTRRecordV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSPTRRecordV2Create,
+		ReadContext:   resourceDNSPTRRecordV2Read,
+		UpdateContext: resourceDNSPTRRecordV2Update,
+		DeleteContext: resourceDNSPTRRecordV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"floatingip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ptrdname": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"disable_status_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSPTRRecordV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	dnsClient, err := config.DNSV2Client(region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	id := fmt.Sprintf("%s:%s", region, d.Get("floatingip_id").(string))
+	setOpts := dnsPTRRecordV2SetOpts{
+		PTRDName:    d.Get("ptrdname").(string),
+		Description: d.Get("description").(string),
+		TTL:         d.Get("ttl").(int),
+	}
+
+	log.Printf("[DEBUG] openstack_dns_ptr_record_v2 %s create options: %#v", id, setOpts)
+
+	_, err = dnsPTRRecordV2Set(dnsClient, id, setOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_ptr_record_v2 %s: %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if !d.Get("disable_status_check").(bool) {
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"ACTIVE"},
+			Pending:    []string{"PENDING"},
+			Refresh:    dnsPTRRecordV2RefreshFunc(dnsClient, id),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf(
+				"Error waiting for openstack_dns_ptr_record_v2 %s to become active: %s", id, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Created openstack_dns_ptr_record_v2 %s", id)
+	return resourceDNSPTRRecordV2Read(ctx, d, meta)
+}
+
+func resourceDNSPTRRecordV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	// Obtain relevant info from parsing the ID
+	region, floatingIPID, err := dnsPTRRecordV2ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dnsClient, err := config.DNSV2Client(region)
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	ptr, err := dnsPTRRecordV2Get(dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_ptr_record_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_ptr_record_v2 %s: %#v", d.Id(), ptr)
+
+	if ptr.PTRDName == "" && ptr.Status != "PENDING" {
+		log.Printf("[DEBUG] openstack_dns_ptr_record_v2 %s is not set anymore, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ptrdname", ptr.PTRDName)
+	d.Set("description", ptr.Description)
+	d.Set("ttl", ptr.TTL)
+	d.Set("address", ptr.Address)
+	d.Set("floatingip_id", floatingIPID)
+	d.Set("region", region)
+
+	return nil
+}
+
+func resourceDNSPTRRecordV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if !d.HasChanges("ptrdname", "description", "ttl") {
+		return resourceDNSPTRRecordV2Read(ctx, d, meta)
+	}
+
+	setOpts := dnsPTRRecordV2SetOpts{
+		PTRDName:    d.Get("ptrdname").(string),
+		Description: d.Get("description").(string),
+		TTL:         d.Get("ttl").(int),
+	}
+
+	log.Printf("[DEBUG] Updating openstack_dns_ptr_record_v2 %s with options: %#v", d.Id(), setOpts)
+
+	_, err = dnsPTRRecordV2Set(dnsClient, d.Id(), setOpts)
+	if err != nil {
+		return diag.Errorf("Error updating openstack_dns_ptr_record_v2 %s: %s", d.Id(), err)
+	}
+
+	if !d.Get("disable_status_check").(bool) {
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"ACTIVE"},
+			Pending:    []string{"PENDING"},
+			Refresh:    dnsPTRRecordV2RefreshFunc(dnsClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf(
+				"Error waiting for openstack_dns_ptr_record_v2 %s to become active: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDNSPTRRecordV2Read(ctx, d, meta)
+}
+
+func resourceDNSPTRRecordV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	err = dnsPTRRecordV2Unset(dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_ptr_record_v2"))
+	}
+
+	if !d.Get("disable_status_check").(bool) {
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"DELETED"},
+			Pending:    []string{"ACTIVE", "PENDING"},
+			Refresh:    dnsPTRRecordV2RefreshFunc(dnsClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf(
+				"Error waiting for openstack_dns_ptr_record_v2 %s to become deleted: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDNSV2PTRRecord_basic(t *testing.T) {
+	var ptr dnsPTRRecordV2
+	ptrName := fmt.Sprintf("ACPTTEST-ptr-%s.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2PTRRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2PTRRecordBasic(ptrName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2PTRRecordExists("openstack_dns_ptr_record_v2.ptr_1", &ptr),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "ptrdname", ptrName),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "description", "a ptr record"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "ttl", "3000"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_ptr_record_v2.ptr_1", "address",
+						"openstack_networking_floatingip_v2.fip_1", "address"),
+				),
+			},
+			{
+				Config: testAccDNSV2PTRRecordUpdate(ptrName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "description", "an updated ptr record"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "ttl", "6000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2PTRRecordDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.DNSV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_dns_ptr_record_v2" {
+			continue
+		}
+
+		ptr, err := dnsPTRRecordV2Get(dnsClient, rs.Primary.ID)
+		if err == nil && ptr.PTRDName != "" {
+			return fmt.Errorf("PTR record still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDNSV2PTRRecordExists(n string, ptr *dnsPTRRecordV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dnsClient, err := config.DNSV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+		}
+
+		found, err := dnsPTRRecordV2Get(dnsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("PTR record not found")
+		}
+
+		*ptr = *found
+
+		return nil
+	}
+}
+
+func testAccDNSV2PTRRecordBasic(ptrName string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  pool = "%s"
+}
+
+resource "openstack_dns_ptr_record_v2" "ptr_1" {
+  floatingip_id = "${openstack_networking_floatingip_v2.fip_1.id}"
+  ptrdname      = "%s"
+  description   = "a ptr record"
+  ttl           = 3000
+}
+`, osPoolName, ptrName)
+}
+
+func testAccDNSV2PTRRecordUpdate(ptrName string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  pool = "%s"
+}
+
+resource "openstack_dns_ptr_record_v2" "ptr_1" {
+  floatingip_id = "${openstack_networking_floatingip_v2.fip_1.id}"
+  ptrdname      = "%s"
+  description   = "an updated ptr record"
+  ttl           = 6000
+}
+`, osPoolName, ptrName)
+}
@@ -0,0 +1,210 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
+)
+
+func resourceDNSZoneImportV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSZoneImportV2Create,
+		ReadContext:   resourceDNSZoneImportV2Read,
+		DeleteContext: resourceDNSZoneImportV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"zone_file": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"keep_zone": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSZoneImportV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	task, err := dnsZoneImportV2Create(dnsClient, d.Get("zone_file").(string))
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_zone_import_v2: %s", err)
+	}
+
+	d.SetId(task.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Target:  []string{"COMPLETE"},
+		Pending: []string{"PENDING"},
+		Refresh: dnsZoneTaskV2RefreshFunc("openstack_dns_zone_import_v2", func() (*dnsZoneTaskV2, error) {
+			return dnsZoneImportV2Get(dnsClient, task.ID)
+		}),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	v, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_dns_zone_import_v2 %s to complete: %s", task.ID, err)
+	}
+
+	zoneID := v.(*dnsZoneTaskV2).ZoneID
+	stateConf = &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    dnsZoneV2RefreshFunc(dnsClient, zoneID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf(
+			"Error waiting for openstack_dns_zone_v2 %s imported by openstack_dns_zone_import_v2 %s to become active: %s", zoneID, task.ID, err)
+	}
+
+	log.Printf("[DEBUG] Created openstack_dns_zone_import_v2 %s of zone %s", task.ID, zoneID)
+	return resourceDNSZoneImportV2Read(ctx, d, meta)
+}
+
+func resourceDNSZoneImportV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	task, err := dnsZoneImportV2Get(dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_zone_import_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_zone_import_v2 %s: %#v", d.Id(), task)
+
+	d.Set("zone_id", task.ZoneID)
+	d.Set("status", task.Status)
+	d.Set("message", task.Message)
+	d.Set("project_id", task.ProjectID)
+	d.Set("created_at", task.CreatedAt)
+	d.Set("updated_at", task.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceDNSZoneImportV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	if !d.Get("keep_zone").(bool) && zoneID != "" {
+		log.Printf("[DEBUG] Deleting openstack_dns_zone_v2 %s imported by openstack_dns_zone_import_v2 %s", zoneID, d.Id())
+		_, err = zones.Delete(dnsClient, zoneID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); !ok {
+				return diag.Errorf("Error deleting openstack_dns_zone_v2 %s imported by openstack_dns_zone_import_v2 %s: %s", zoneID, d.Id(), err)
+			}
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"DELETED"},
+			Pending:    []string{"ACTIVE", "PENDING"},
+			Refresh:    dnsZoneV2RefreshFunc(dnsClient, zoneID),
+			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf(
+				"Error waiting for openstack_dns_zone_v2 %s to become deleted: %s", zoneID, err)
+		}
+	}
+
+	err = dnsZoneImportV2Delete(dnsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_zone_import_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
+)
+
+func TestAccDNSV2ZoneImport_basic(t *testing.T) {
+	var zone zones.Zone
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2ZoneImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2ZoneImportBasic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneImportZoneExists("openstack_dns_zone_import_v2.import_1", &zone),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_zone_import_v2.import_1", "status", "COMPLETE"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_dns_zone_import_v2.import_1", "zone_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDNSV2ZoneImport_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckDNS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDNSV2ZoneImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDNSV2ZoneImportInvalid,
+				ExpectError: regexp.MustCompile(`openstack_dns_zone_import_v2`),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2ZoneImportDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.DNSV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_dns_zone_import_v2" {
+			continue
+		}
+
+		_, err := dnsZoneImportV2Get(dnsClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Zone import still exists")
+		}
+
+		if zoneID := rs.Primary.Attributes["zone_id"]; zoneID != "" {
+			_, err = zones.Get(dnsClient, zoneID).Extract()
+			if err == nil {
+				return fmt.Errorf("Imported zone still exists")
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDNSV2ZoneImportZoneExists(n string, zone *zones.Zone) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dnsClient, err := config.DNSV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+		}
+
+		found, err := zones.Get(dnsClient, rs.Primary.Attributes["zone_id"]).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.Status != "ACTIVE" {
+			return fmt.Errorf("Imported zone %s is %s", found.ID, found.Status)
+		}
+
+		*zone = *found
+
+		return nil
+	}
+}
+
+func testAccDNSV2ZoneImportBasic(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "openstack_dns_zone_import_v2" "import_1" {
+			zone_file = <<EOT
+$ORIGIN %[1]s
+$TTL 3600
+%[1]s IN SOA ns1.%[1]s admin.%[1]s 1 3600 600 86400 3600
+%[1]s IN NS ns1.%[1]s
+www.%[1]s IN A 10.1.0.1
+EOT
+		}
+	`, zoneName)
+}
+
+const testAccDNSV2ZoneImportInvalid = `
+resource "openstack_dns_zone_import_v2" "import_1" {
+  zone_file = "this is not a zone file"
+}
+`
@@ -0,0 +1,147 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDNSZoneShareV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSZoneShareV2Create,
+		ReadContext:   resourceDNSZoneShareV2Read,
+		DeleteContext: resourceDNSZoneShareV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"target_project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSZoneShareV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	createOpts := dnsZoneShareV2CreateOpts{
+		TargetProjectID: d.Get("target_project_id").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_dns_zone_share_v2 create options: %#v", createOpts)
+
+	share, err := dnsZoneShareV2Create(dnsClient, zoneID, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_dns_zone_share_v2: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", zoneID, share.ID))
+
+	log.Printf("[DEBUG] Created openstack_dns_zone_share_v2 %s: %#v", share.ID, share)
+	return resourceDNSZoneShareV2Read(ctx, d, meta)
+}
+
+func resourceDNSZoneShareV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	// Obtain relevant info from parsing the ID
+	zoneID, shareID, err := dnsZoneShareV2ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	share, err := dnsZoneShareV2Get(dnsClient, zoneID, shareID)
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_dns_zone_share_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_zone_share_v2 %s: %#v", shareID, share)
+
+	d.Set("zone_id", zoneID)
+	d.Set("target_project_id", share.TargetProjectID)
+	d.Set("project_id", share.ProjectID)
+	d.Set("created_at", share.CreatedAt)
+	d.Set("updated_at", share.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceDNSZoneShareV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if err := dnsClientSetAuthHeader(d, dnsClient); err != nil {
+		return diag.Errorf("Error setting dns client auth headers: %s", err)
+	}
+
+	// Obtain relevant info from parsing the ID
+	zoneID, shareID, err := dnsZoneShareV2ParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = dnsZoneShareV2Delete(dnsClient, zoneID, shareID)
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_dns_zone_share_v2"))
+	}
+
+	return nil
+}
@@ -3,12 +3,15 @@ package openstack
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -28,10 +31,14 @@ func resourceImagesImageV2() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: resourceImagesImageV2UpdateComputedAttributes,
+		CustomizeDiff: customdiff.Sequence(
+			imagesMetadefV2CustomizeDiffValidate("properties", "validate_properties", "OS::Glance::Image"),
+			resourceImagesImageV2UpdateComputedAttributes,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -169,11 +176,41 @@ func resourceImagesImageV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"validate_properties": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"web_download": {
 				Type:          schema.TypeBool,
 				Optional:      true,
 				ForceNew:      false,
-				ConflictsWith: []string{"local_file_path", "verify_checksum", "decompress"},
+				ConflictsWith: []string{"local_file_path", "verify_checksum", "decompress", "import_method"},
+			},
+
+			"import_method": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"web_download"},
+				ValidateFunc: validation.StringInSlice([]string{
+					string(imageimport.GlanceDirectMethod), string(imageimport.WebDownloadMethod),
+				}, false),
+			},
+
+			"stores": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"all_stores_must_succeed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 
 			"decompress": {
@@ -183,6 +220,24 @@ func resourceImagesImageV2() *schema.Resource {
 				ConflictsWith: []string{"web_download"},
 			},
 
+			"stream_upload": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"local_file_path", "web_download"},
+			},
+
+			"expected_hash": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"web_download"},
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^(md5|sha256|sha512):[0-9a-fA-F]+$`),
+					"must have the format <md5|sha256|sha512>:<hex value>",
+				),
+			},
+
 			// Computed-only
 			"checksum": {
 				Type:     schema.TypeString,
@@ -268,6 +323,23 @@ func resourceImagesImageV2Create(ctx context.Context, d *schema.ResourceData, me
 		createOpts.Tags = resourceImagesImageV2BuildTags(tags)
 	}
 
+	importMethod := imagesImageV2ImportMethod(d)
+	if d.Get("stream_upload").(bool) && d.Get("image_source_url").(string) == "" {
+		return diag.Errorf("Error creating Image: image_source_url is required for stream_upload")
+	}
+
+	if importMethod == imageimport.WebDownloadMethod {
+		if d.Get("stream_upload").(bool) || d.Get("expected_hash").(string) != "" {
+			return diag.Errorf("Error creating Image: stream_upload and expected_hash can't be used with the %s import method", importMethod)
+		}
+		if d.Get("image_source_url").(string) == "" {
+			return diag.Errorf("Error creating Image: image_source_url is required for the %s import method", importMethod)
+		}
+		if d.Get("local_file_path").(string) != "" || d.Get("decompress").(bool) {
+			return diag.Errorf("Error creating Image: local_file_path and decompress can't be used with the %s import method", importMethod)
+		}
+	}
+
 	d.Partial(true)
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -278,65 +350,94 @@ func resourceImagesImageV2Create(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(newImg.ID)
 
-	var fileChecksum string
-	useWebDownload := d.Get("web_download").(bool)
-	if useWebDownload {
-		// import
-		imgURL := d.Get("image_source_url").(string)
+	var diags diag.Diagnostics
+	hasher := newImagesImageV2Hasher()
+	if importMethod != imageimport.WebDownloadMethod {
+		var imgReader io.Reader
+		var imgSource string
+
+		if d.Get("stream_upload").(bool) {
+			// stream the download directly into the upload
+			imgSource = d.Get("image_source_url").(string)
+			source, err := imagesImageV2OpenSource(ctx, imageClient, d)
+			if err != nil {
+				return diag.Errorf("Error opening image source for Image: %s", err)
+			}
+			defer source.Close()
+			imgReader = source
+			log.Printf("[WARN] Streaming image %s from %q. This can be pretty long.", d.Id(), imgSource)
+		} else {
+			// downloading/getting image file
+			imgFilePath, err := resourceImagesImageV2File(imageClient, d, config.MutexKV)
+			if err != nil {
+				return diag.Errorf("Error opening file for Image: %s", err)
+			}
+			imgSource = imgFilePath
 
-		importOpts := &imageimport.CreateOpts{
-			Name: imageimport.WebDownloadMethod,
-			URI:  imgURL,
-		}
+			imgFile, err := os.Open(imgFilePath)
+			if err != nil {
+				return diag.Errorf("Error opening file %q: %s", imgFilePath, err)
+			}
+			defer imgFile.Close()
 
-		log.Printf("[DEBUG] Import Options: %#v", importOpts)
-		err = imageimport.Create(imageClient, d.Id(), importOpts).ExtractErr()
-		if err != nil {
-			return diag.Errorf("Error while importing url %q: %s", imgURL, err)
+			fileInfo, err := imgFile.Stat()
+			if err != nil {
+				return diag.Errorf("Error reading image file %q: %s", imgFilePath, err)
+			}
+			imgReader = imgFile
+			log.Printf("[WARN] Uploading image %s (%d bytes). This can be pretty long.", d.Id(), fileInfo.Size())
 		}
-	} else {
-		// variable declaration
-		var err error
-		var imgFilePath string
-		var fileSize int64
-		var imgFile *os.File
-
-		// downloading/getting image file props
-		imgFilePath, err = resourceImagesImageV2File(imageClient, d, config.MutexKV)
-		if err != nil {
-			return diag.Errorf("Error opening file for Image: %s", err)
+
+		// upload, while computing the checksums
+		imgReader = io.TeeReader(imgReader, hasher)
+		if importMethod == imageimport.GlanceDirectMethod {
+			err = imagedata.Stage(imageClient, d.Id(), imgReader).ExtractErr()
+		} else {
+			err = imagedata.Upload(imageClient, d.Id(), imgReader).ExtractErr()
 		}
-		fileSize, fileChecksum, err = resourceImagesImageV2FileProps(imgFilePath)
 		if err != nil {
-			return diag.Errorf("Error getting file props: %s", err)
+			return diag.Errorf("Error while uploading %q: %s", imgSource, err)
 		}
 
-		// upload
-		imgFile, err = os.Open(imgFilePath)
-		if err != nil {
-			return diag.Errorf("Error opening file %q: %s", imgFilePath, err)
+		if err := imagesImageV2VerifyExpectedHash(d.Get("expected_hash").(string), hasher); err != nil {
+			log.Printf("[DEBUG] Deleting Image %s with unexpected data", d.Id())
+			if derr := images.Delete(imageClient, d.Id()).ExtractErr(); derr != nil {
+				return diag.Errorf("Error verifying %q: %s. Unable to delete Image %s: %s", imgSource, err, d.Id(), derr)
+			}
+			d.SetId("")
+			return diag.Errorf("Error verifying %q: %s", imgSource, err)
 		}
-		defer imgFile.Close()
-		log.Printf("[WARN] Uploading image %s (%d bytes). This can be pretty long.", d.Id(), fileSize)
+	}
 
-		err = imagedata.Upload(imageClient, d.Id(), imgFile).ExtractErr()
-		if err != nil {
-			return diag.Errorf("Error while uploading file %q: %s", imgFilePath, err)
+	if importMethod != "" {
+		// import
+		importOpts := imagesImageV2ImportOpts{
+			Method:               importMethod,
+			Stores:               expandToStringSlice(d.Get("stores").(*schema.Set).List()),
+			AllStoresMustSucceed: d.Get("all_stores_must_succeed").(bool),
+		}
+		if importMethod == imageimport.WebDownloadMethod {
+			importOpts.URI = d.Get("image_source_url").(string)
 		}
-	}
 
-	//wait for active
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{string(images.ImageStatusQueued), string(images.ImageStatusSaving), string(images.ImageStatusImporting)},
-		Target:     []string{string(images.ImageStatusActive)},
-		Refresh:    resourceImagesImageV2RefreshFunc(imageClient, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
+		diags = imagesImageV2Import(ctx, imageClient, d.Id(), importOpts, d.Timeout(schema.TimeoutCreate))
+		if diags.HasError() {
+			return diags
+		}
+	} else {
+		//wait for active
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{string(images.ImageStatusQueued), string(images.ImageStatusSaving), string(images.ImageStatusImporting)},
+			Target:     []string{string(images.ImageStatusActive)},
+			Refresh:    resourceImagesImageV2RefreshFunc(imageClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
 
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-		return diag.Errorf("Error waiting for Image: %s", err)
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+			return diag.Errorf("Error waiting for Image: %s", err)
+		}
 	}
 
 	img, err := images.Get(imageClient, d.Id()).Extract()
@@ -344,15 +445,23 @@ func resourceImagesImageV2Create(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(CheckDeleted(d, err, "image"))
 	}
 
-	if v, ok := d.GetOkExists("verify_checksum"); !useWebDownload && (!ok || (ok && v.(bool))) {
-		if img.Checksum != fileChecksum {
+	if v, ok := d.GetOkExists("verify_checksum"); importMethod != imageimport.WebDownloadMethod && (!ok || (ok && v.(bool))) {
+		if fileChecksum := hasher.Sum("md5"); img.Checksum != fileChecksum {
 			return diag.Errorf("Error wrong checksum: got %q, expected %q", img.Checksum, fileChecksum)
 		}
+
+		// os_hash_value is computed by Glance using the os_hash_algo algorithm,
+		// which is sha512 by default.
+		algo, _ := img.Properties["os_hash_algo"].(string)
+		value, _ := img.Properties["os_hash_value"].(string)
+		if fileHash := hasher.Sum(algo); value != "" && fileHash != "" && value != fileHash {
+			return diag.Errorf("Error wrong %s hash: got %q, expected %q", algo, value, fileHash)
+		}
 	}
 
 	d.Partial(false)
 
-	return resourceImagesImageV2Read(ctx, d, meta)
+	return append(diags, resourceImagesImageV2Read(ctx, d, meta)...)
 }
 
 func resourceImagesImageV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -390,6 +499,7 @@ func resourceImagesImageV2Read(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("size_bytes", img.SizeBytes)
 	d.Set("tags", img.Tags)
 	d.Set("visibility", img.Visibility)
+	d.Set("stores", imagesImageV2PropertyList(img.Properties, "stores"))
 	d.Set("region", GetRegion(d, config))
 
 	// Deprecated
@@ -536,7 +646,33 @@ func resourceImagesImageV2Update(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("Error updating image: %s", err)
 	}
 
-	return resourceImagesImageV2Read(ctx, d, meta)
+	var diags diag.Diagnostics
+	if d.HasChange("stores") {
+		o, n := d.GetChange("stores")
+		oldStores, newStores := o.(*schema.Set), n.(*schema.Set)
+
+		if added := newStores.Difference(oldStores); added.Len() > 0 {
+			importOpts := imagesImageV2ImportOpts{
+				Method:               imagesImageV2CopyImageMethod,
+				Stores:               expandToStringSlice(added.List()),
+				AllStoresMustSucceed: d.Get("all_stores_must_succeed").(bool),
+			}
+
+			diags = imagesImageV2Import(ctx, imageClient, d.Id(), importOpts, d.Timeout(schema.TimeoutUpdate))
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		for _, store := range oldStores.Difference(newStores).List() {
+			log.Printf("[DEBUG] Deleting Image %s from store %s", d.Id(), store)
+			if err := imagesImageV2DeleteFromStore(imageClient, d.Id(), store.(string)); err != nil {
+				return append(diags, diag.Errorf("Error deleting Image %s from store %s: %s", d.Id(), store, err)...)
+			}
+		}
+	}
+
+	return append(diags, resourceImagesImageV2Read(ctx, d, meta)...)
 }
 
 func resourceImagesImageV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
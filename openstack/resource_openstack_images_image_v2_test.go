@@ -2,6 +2,8 @@ package openstack
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -278,6 +280,141 @@ func TestAccImagesImageV2_webdownload(t *testing.T) {
 	})
 }
 
+func TestAccImagesImageV2_glanceDirect(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckGlanceImport(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImageV2GlanceDirect,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "import_method", "glance-direct"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImagesImageV2_streamUpload(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccImagesImageV2StreamUploadHashMismatch,
+				ExpectError: regexp.MustCompile(`sha256 checksum mismatch`),
+			},
+			{
+				Config: testAccImagesImageV2StreamUpload,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "stream_upload", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImagesImageV2_stores(t *testing.T) {
+	var image images.Image
+
+	// The stores are needed to build the configurations.
+	testAccPreCheckGlanceStores(t)
+	stores := strings.Split(osGlanceStores, ",")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckGlanceImport(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImageV2Stores(stores[:1]),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "stores.#", "1"),
+					resource.TestCheckTypeSetElemAttr(
+						"openstack_images_image_v2.image_1", "stores.*", stores[0]),
+				),
+			},
+			{
+				Config: testAccImagesImageV2Stores(stores[:2]),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "stores.#", "2"),
+					resource.TestCheckTypeSetElemAttr(
+						"openstack_images_image_v2.image_1", "stores.*", stores[1]),
+				),
+			},
+			{
+				Config: testAccImagesImageV2Stores(stores[1:2]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "stores.#", "1"),
+					resource.TestCheckTypeSetElemAttr(
+						"openstack_images_image_v2.image_1", "stores.*", stores[1]),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImagesImageV2_validateProperties(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccImagesImageV2ValidateProperties("hw_disk_bus", "foo"),
+				ExpectError: regexp.MustCompile(`"hw_disk_bus": must be one of`),
+			},
+			{
+				Config:      testAccImagesImageV2ValidateProperties("hw_disk_buss", "scsi"),
+				ExpectError: regexp.MustCompile(`did you mean "hw_disk_bus"`),
+			},
+			{
+				Config: testAccImagesImageV2ValidateProperties("hw_disk_bus", "scsi"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "properties.hw_disk_bus", "scsi"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckImagesImageV2Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	imageClient, err := config.ImageV2Client(osRegionName)
@@ -562,3 +699,75 @@ const testAccImagesImageV2Webdownload = `
         create = "10m"
       }
   }`
+
+const testAccImagesImageV2GlanceDirect = `
+  resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      import_method = "glance-direct"
+
+      timeouts {
+        create = "10m"
+      }
+  }`
+
+const testAccImagesImageV2StreamUpload = `
+  resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      stream_upload = true
+
+      timeouts {
+        create = "10m"
+      }
+  }`
+
+const testAccImagesImageV2StreamUploadHashMismatch = `
+  resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      stream_upload = true
+      expected_hash = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+      timeouts {
+        create = "10m"
+      }
+  }`
+
+func testAccImagesImageV2Stores(stores []string) string {
+	return fmt.Sprintf(`
+  resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      import_method = "glance-direct"
+      stores = ["%s"]
+
+      timeouts {
+        create = "10m"
+        update = "10m"
+      }
+  }`, strings.Join(stores, `", "`))
+}
+
+func testAccImagesImageV2ValidateProperties(key, value string) string {
+	return fmt.Sprintf(`
+  resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      validate_properties = true
+
+      properties = {
+        %s = "%s"
+      }
+  }`, key, value)
+}
@@ -0,0 +1,220 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func resourceImagesMetadefNamespaceV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImagesMetadefNamespaceV2Create,
+		ReadContext:   resourceImagesMetadefNamespaceV2Read,
+		UpdateContext: resourceImagesMetadefNamespaceV2Update,
+		DeleteContext: resourceImagesMetadefNamespaceV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"visibility": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "private",
+				ValidateFunc: validation.StringInSlice([]string{
+					"public", "private",
+				}, false),
+			},
+
+			"protected": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"resource_type_association": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"properties_target": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImagesMetadefNamespaceV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	createOpts := imagesMetadefV2NamespaceOpts{
+		Namespace:                d.Get("namespace").(string),
+		DisplayName:              d.Get("display_name").(string),
+		Description:              d.Get("description").(string),
+		Visibility:               d.Get("visibility").(string),
+		Protected:                d.Get("protected").(bool),
+		ResourceTypeAssociations: expandImagesMetadefV2ResourceTypeAssociations(d.Get("resource_type_association").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] openstack_images_metadef_namespace_v2 create options: %#v", createOpts)
+	ns, err := imagesMetadefV2NamespaceCreate(imageClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_images_metadef_namespace_v2 %s: %s", createOpts.Namespace, err)
+	}
+
+	d.SetId(ns.Namespace)
+
+	return resourceImagesMetadefNamespaceV2Read(ctx, d, meta)
+}
+
+func resourceImagesMetadefNamespaceV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	ns, err := imagesMetadefV2NamespaceGet(imageClient, d.Id(), "")
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_images_metadef_namespace_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_images_metadef_namespace_v2 %s: %#v", d.Id(), ns)
+
+	d.Set("namespace", ns.Namespace)
+	d.Set("display_name", ns.DisplayName)
+	d.Set("description", ns.Description)
+	d.Set("visibility", ns.Visibility)
+	d.Set("protected", ns.Protected)
+	d.Set("owner", ns.Owner)
+	d.Set("created_at", ns.CreatedAt)
+	d.Set("updated_at", ns.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("resource_type_association", flattenImagesMetadefV2ResourceTypeAssociations(ns.ResourceTypeAssociations)); err != nil {
+		log.Printf("[WARN] Unable to set resource_type_association for openstack_images_metadef_namespace_v2 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceImagesMetadefNamespaceV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	if d.HasChanges("display_name", "description", "visibility", "protected") {
+		updateOpts := imagesMetadefV2NamespaceOpts{
+			Namespace:   d.Id(),
+			DisplayName: d.Get("display_name").(string),
+			Description: d.Get("description").(string),
+			Visibility:  d.Get("visibility").(string),
+			Protected:   d.Get("protected").(bool),
+		}
+
+		log.Printf("[DEBUG] openstack_images_metadef_namespace_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err := imagesMetadefV2NamespaceUpdate(imageClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_images_metadef_namespace_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("resource_type_association") {
+		o, n := d.GetChange("resource_type_association")
+		oldAssociations, newAssociations := o.(*schema.Set), n.(*schema.Set)
+
+		for _, a := range expandImagesMetadefV2ResourceTypeAssociations(oldAssociations.Difference(newAssociations).List()) {
+			log.Printf("[DEBUG] Removing resource type association %s from openstack_images_metadef_namespace_v2 %s", a.Name, d.Id())
+			err := imagesMetadefV2ResourceTypeAssociationDelete(imageClient, d.Id(), a.Name)
+			if err != nil {
+				if _, ok := err.(gophercloud.ErrDefault404); !ok {
+					return diag.Errorf("Error removing resource type association %s from openstack_images_metadef_namespace_v2 %s: %s", a.Name, d.Id(), err)
+				}
+			}
+		}
+
+		for _, a := range expandImagesMetadefV2ResourceTypeAssociations(newAssociations.Difference(oldAssociations).List()) {
+			log.Printf("[DEBUG] Adding resource type association %s to openstack_images_metadef_namespace_v2 %s", a.Name, d.Id())
+			if err := imagesMetadefV2ResourceTypeAssociationCreate(imageClient, d.Id(), a); err != nil {
+				return diag.Errorf("Error adding resource type association %s to openstack_images_metadef_namespace_v2 %s: %s", a.Name, d.Id(), err)
+			}
+		}
+	}
+
+	return resourceImagesMetadefNamespaceV2Read(ctx, d, meta)
+}
+
+func resourceImagesMetadefNamespaceV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	if err := imagesMetadefV2NamespaceDelete(imageClient, d.Id()); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_images_metadef_namespace_v2"))
+	}
+
+	return nil
+}
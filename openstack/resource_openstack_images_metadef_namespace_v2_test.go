@@ -0,0 +1,138 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccImagesMetadefNamespaceV2_basic(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckImagesMetadefNamespaceV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesMetadefNamespaceV2Basic(namespace),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefNamespaceV2Exists("openstack_images_metadef_namespace_v2.namespace_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "namespace", namespace),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "display_name", "Terraform"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "visibility", "private"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "resource_type_association.#", "1"),
+				),
+			},
+			{
+				Config: testAccImagesMetadefNamespaceV2Update(namespace),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefNamespaceV2Exists("openstack_images_metadef_namespace_v2.namespace_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "display_name", "Terraform updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "visibility", "public"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "resource_type_association.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesMetadefNamespaceV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.ImageV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_images_metadef_namespace_v2" {
+			continue
+		}
+
+		_, err := imagesMetadefV2NamespaceGet(imageClient, rs.Primary.ID, "")
+		if err == nil {
+			return fmt.Errorf("Metadef namespace still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImagesMetadefNamespaceV2Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		imageClient, err := config.ImageV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack image client: %s", err)
+		}
+
+		found, err := imagesMetadefV2NamespaceGet(imageClient, rs.Primary.ID, "")
+		if err != nil {
+			return err
+		}
+
+		if found.Namespace != rs.Primary.ID {
+			return fmt.Errorf("Metadef namespace not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccImagesMetadefNamespaceV2Basic(namespace string) string {
+	return fmt.Sprintf(`
+resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+  namespace    = "%s"
+  display_name = "Terraform"
+  description  = "Terraform acceptance test"
+
+  resource_type_association {
+    name   = "OS::Glance::Image"
+    prefix = "tf_"
+  }
+}
+`, namespace)
+}
+
+func testAccImagesMetadefNamespaceV2Update(namespace string) string {
+	return fmt.Sprintf(`
+resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+  namespace    = "%s"
+  display_name = "Terraform updated"
+  description  = "Terraform acceptance test"
+  visibility   = "public"
+
+  resource_type_association {
+    name   = "OS::Glance::Image"
+    prefix = "tf_"
+  }
+
+  resource_type_association {
+    name   = "OS::Nova::Flavor"
+    prefix = "tf:"
+  }
+}
+`, namespace)
+}
@@ -0,0 +1,197 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceImagesMetadefObjectV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImagesMetadefObjectV2Create,
+		ReadContext:   resourceImagesMetadefObjectV2Read,
+		UpdateContext: resourceImagesMetadefObjectV2Update,
+		DeleteContext: resourceImagesMetadefObjectV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"required": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"properties": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: imagesMetadefV2SuppressEquivalentJSON,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImagesMetadefObjectV2Opts(d *schema.ResourceData) (imagesMetadefV2Object, error) {
+	opts := imagesMetadefV2Object{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Required:    expandToStringSlice(d.Get("required").([]interface{})),
+	}
+
+	if v := d.Get("properties").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &opts.Properties); err != nil {
+			return opts, fmt.Errorf("Error parsing properties: %s", err)
+		}
+	}
+
+	return opts, nil
+}
+
+func resourceImagesMetadefObjectV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace := d.Get("namespace").(string)
+	createOpts, err := resourceImagesMetadefObjectV2Opts(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] openstack_images_metadef_object_v2 create options: %#v", createOpts)
+	object, err := imagesMetadefV2ObjectCreate(imageClient, namespace, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_images_metadef_object_v2 %s/%s: %s", namespace, createOpts.Name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", namespace, object.Name))
+
+	return resourceImagesMetadefObjectV2Read(ctx, d, meta)
+}
+
+func resourceImagesMetadefObjectV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefV2ID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	object, err := imagesMetadefV2ObjectGet(imageClient, namespace, name)
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_images_metadef_object_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_images_metadef_object_v2 %s: %#v", d.Id(), object)
+
+	d.Set("namespace", namespace)
+	d.Set("name", object.Name)
+	d.Set("description", object.Description)
+	d.Set("required", object.Required)
+	d.Set("created_at", object.CreatedAt)
+	d.Set("updated_at", object.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	properties := ""
+	if len(object.Properties) > 0 {
+		b, err := json.Marshal(object.Properties)
+		if err != nil {
+			return diag.Errorf("Error serializing properties of openstack_images_metadef_object_v2 %s: %s", d.Id(), err)
+		}
+		properties = string(b)
+	}
+	d.Set("properties", properties)
+
+	return nil
+}
+
+func resourceImagesMetadefObjectV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefV2ID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateOpts, err := resourceImagesMetadefObjectV2Opts(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] openstack_images_metadef_object_v2 %s update options: %#v", d.Id(), updateOpts)
+	_, err = imagesMetadefV2ObjectUpdate(imageClient, namespace, name, updateOpts)
+	if err != nil {
+		return diag.Errorf("Error updating openstack_images_metadef_object_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceImagesMetadefObjectV2Read(ctx, d, meta)
+}
+
+func resourceImagesMetadefObjectV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefV2ID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := imagesMetadefV2ObjectDelete(imageClient, namespace, name); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_images_metadef_object_v2"))
+	}
+
+	return nil
+}
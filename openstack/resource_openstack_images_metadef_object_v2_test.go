@@ -0,0 +1,116 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccImagesMetadefObjectV2_basic(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckImagesMetadefObjectV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesMetadefObjectV2Basic(namespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_object_v2.object_1", "name", "Watchdog"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_object_v2.object_1", "required.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_images_metadef_object_v2.object_1", "properties"),
+				),
+			},
+			{
+				Config: testAccImagesMetadefObjectV2Update(namespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_object_v2.object_1", "description", "Updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_object_v2.object_1", "required.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesMetadefObjectV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.ImageV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_images_metadef_object_v2" {
+			continue
+		}
+
+		namespace, name, err := parseImagesMetadefV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = imagesMetadefV2ObjectGet(imageClient, namespace, name)
+		if err == nil {
+			return fmt.Errorf("Metadef object still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccImagesMetadefObjectV2Basic(namespace string) string {
+	return fmt.Sprintf(`
+resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+  namespace = "%s"
+}
+
+resource "openstack_images_metadef_object_v2" "object_1" {
+  namespace   = openstack_images_metadef_namespace_v2.namespace_1.namespace
+  name        = "Watchdog"
+  description = "Watchdog behavior"
+  required    = ["tf_watchdog_action"]
+
+  properties = jsonencode({
+    tf_watchdog_action = {
+      title = "Watchdog Action"
+      type  = "string"
+      enum  = ["disabled", "reset", "poweroff", "pause", "none"]
+    }
+  })
+}
+`, namespace)
+}
+
+func testAccImagesMetadefObjectV2Update(namespace string) string {
+	return fmt.Sprintf(`
+resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+  namespace = "%s"
+}
+
+resource "openstack_images_metadef_object_v2" "object_1" {
+  namespace   = openstack_images_metadef_namespace_v2.namespace_1.namespace
+  name        = "Watchdog"
+  description = "Updated"
+
+  properties = jsonencode({
+    tf_watchdog_action = {
+      title = "Watchdog Action"
+      type  = "string"
+      enum  = ["disabled", "reset"]
+    }
+  })
+}
+`, namespace)
+}
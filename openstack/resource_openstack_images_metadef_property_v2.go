@@ -0,0 +1,311 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceImagesMetadefPropertyV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImagesMetadefPropertyV2Create,
+		ReadContext:   resourceImagesMetadefPropertyV2Read,
+		UpdateContext: resourceImagesMetadefPropertyV2Update,
+		DeleteContext: resourceImagesMetadefPropertyV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"string", "integer", "number", "boolean", "array",
+				}, false),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enum": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"default": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"minimum": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+
+			"maximum": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+
+			"min_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"readonly": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"items": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enum": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceImagesMetadefPropertyV2Opts(d *schema.ResourceData) imagesMetadefV2Property {
+	opts := imagesMetadefV2Property{
+		Name:        d.Get("name").(string),
+		Title:       d.Get("title").(string),
+		Type:        d.Get("type").(string),
+		Description: d.Get("description").(string),
+		Pattern:     d.Get("pattern").(string),
+		ReadOnly:    d.Get("readonly").(bool),
+	}
+
+	for _, v := range d.Get("enum").([]interface{}) {
+		opts.Enum = append(opts.Enum, v)
+	}
+
+	if v, ok := d.GetOk("default"); ok {
+		opts.Default = v
+	}
+
+	if v, ok := d.GetOkExists("minimum"); ok {
+		minimum := v.(float64)
+		opts.Minimum = &minimum
+	}
+
+	if v, ok := d.GetOkExists("maximum"); ok {
+		maximum := v.(float64)
+		opts.Maximum = &maximum
+	}
+
+	if v, ok := d.GetOkExists("min_length"); ok {
+		minLength := v.(int)
+		opts.MinLength = &minLength
+	}
+
+	if v, ok := d.GetOkExists("max_length"); ok {
+		maxLength := v.(int)
+		opts.MaxLength = &maxLength
+	}
+
+	if v, ok := d.GetOk("items"); ok {
+		items := v.([]interface{})[0].(map[string]interface{})
+		opts.Items = &imagesMetadefV2PropertyItems{
+			Type: items["type"].(string),
+		}
+		for _, e := range items["enum"].([]interface{}) {
+			opts.Items.Enum = append(opts.Items.Enum, e)
+		}
+	}
+
+	return opts
+}
+
+func flattenImagesMetadefV2PropertyEnum(enum []interface{}) []string {
+	result := make([]string, 0, len(enum))
+	for _, v := range enum {
+		result = append(result, fmt.Sprint(v))
+	}
+
+	return result
+}
+
+func resourceImagesMetadefPropertyV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace := d.Get("namespace").(string)
+	createOpts := resourceImagesMetadefPropertyV2Opts(d)
+
+	log.Printf("[DEBUG] openstack_images_metadef_property_v2 create options: %#v", createOpts)
+	property, err := imagesMetadefV2PropertyCreate(imageClient, namespace, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_images_metadef_property_v2 %s/%s: %s", namespace, createOpts.Name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", namespace, property.Name))
+
+	return resourceImagesMetadefPropertyV2Read(ctx, d, meta)
+}
+
+func resourceImagesMetadefPropertyV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefV2ID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	property, err := imagesMetadefV2PropertyGet(imageClient, namespace, name)
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_images_metadef_property_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_images_metadef_property_v2 %s: %#v", d.Id(), property)
+
+	d.Set("namespace", namespace)
+	d.Set("name", property.Name)
+	d.Set("title", property.Title)
+	d.Set("type", property.Type)
+	d.Set("description", property.Description)
+	d.Set("enum", flattenImagesMetadefV2PropertyEnum(property.Enum))
+	d.Set("pattern", property.Pattern)
+	d.Set("readonly", property.ReadOnly)
+	d.Set("region", GetRegion(d, config))
+
+	if property.Default != nil {
+		d.Set("default", fmt.Sprint(property.Default))
+	} else {
+		d.Set("default", "")
+	}
+
+	if property.Minimum != nil {
+		d.Set("minimum", *property.Minimum)
+	}
+	if property.Maximum != nil {
+		d.Set("maximum", *property.Maximum)
+	}
+	if property.MinLength != nil {
+		d.Set("min_length", *property.MinLength)
+	}
+	if property.MaxLength != nil {
+		d.Set("max_length", *property.MaxLength)
+	}
+
+	var items []map[string]interface{}
+	if property.Items != nil {
+		items = append(items, map[string]interface{}{
+			"type": property.Items.Type,
+			"enum": flattenImagesMetadefV2PropertyEnum(property.Items.Enum),
+		})
+	}
+	if err := d.Set("items", items); err != nil {
+		log.Printf("[WARN] Unable to set items for openstack_images_metadef_property_v2 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceImagesMetadefPropertyV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefV2ID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateOpts := resourceImagesMetadefPropertyV2Opts(d)
+
+	log.Printf("[DEBUG] openstack_images_metadef_property_v2 %s update options: %#v", d.Id(), updateOpts)
+	_, err = imagesMetadefV2PropertyUpdate(imageClient, namespace, name, updateOpts)
+	if err != nil {
+		return diag.Errorf("Error updating openstack_images_metadef_property_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceImagesMetadefPropertyV2Read(ctx, d, meta)
+}
+
+func resourceImagesMetadefPropertyV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	imageClient, err := config.ImageV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefV2ID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := imagesMetadefV2PropertyDelete(imageClient, namespace, name); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_images_metadef_property_v2"))
+	}
+
+	return nil
+}
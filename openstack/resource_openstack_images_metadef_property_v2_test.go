@@ -0,0 +1,128 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccImagesMetadefPropertyV2_basic(t *testing.T) {
+	namespace := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckImagesMetadefPropertyV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesMetadefPropertyV2Basic(namespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_property_v2.property_1", "name", "tf_disk_bus"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_property_v2.property_1", "type", "string"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_property_v2.property_1", "enum.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_property_v2.property_2", "minimum", "0"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_property_v2.property_2", "maximum", "16"),
+				),
+			},
+			{
+				Config: testAccImagesMetadefPropertyV2Update(namespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_property_v2.property_1", "title", "Disk bus updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_property_v2.property_1", "enum.#", "3"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_property_v2.property_1", "default", "scsi"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesMetadefPropertyV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.ImageV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_images_metadef_property_v2" {
+			continue
+		}
+
+		namespace, name, err := parseImagesMetadefV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = imagesMetadefV2PropertyGet(imageClient, namespace, name)
+		if err == nil {
+			return fmt.Errorf("Metadef property still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccImagesMetadefPropertyV2Basic(namespace string) string {
+	return fmt.Sprintf(`
+resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+  namespace = "%s"
+}
+
+resource "openstack_images_metadef_property_v2" "property_1" {
+  namespace = openstack_images_metadef_namespace_v2.namespace_1.namespace
+  name      = "tf_disk_bus"
+  title     = "Disk bus"
+  type      = "string"
+  enum      = ["scsi", "virtio"]
+}
+
+resource "openstack_images_metadef_property_v2" "property_2" {
+  namespace = openstack_images_metadef_namespace_v2.namespace_1.namespace
+  name      = "tf_queues"
+  title     = "Queues"
+  type      = "integer"
+  minimum   = 0
+  maximum   = 16
+}
+`, namespace)
+}
+
+func testAccImagesMetadefPropertyV2Update(namespace string) string {
+	return fmt.Sprintf(`
+resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+  namespace = "%s"
+}
+
+resource "openstack_images_metadef_property_v2" "property_1" {
+  namespace = openstack_images_metadef_namespace_v2.namespace_1.namespace
+  name      = "tf_disk_bus"
+  title     = "Disk bus updated"
+  type      = "string"
+  enum      = ["scsi", "virtio", "sata"]
+  default   = "scsi"
+}
+
+resource "openstack_images_metadef_property_v2" "property_2" {
+  namespace = openstack_images_metadef_namespace_v2.namespace_1.namespace
+  name      = "tf_queues"
+  title     = "Queues"
+  type      = "integer"
+  minimum   = 0
+  maximum   = 16
+}
+`, namespace)
+}
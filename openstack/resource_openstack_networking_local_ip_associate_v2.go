@@ -0,0 +1,135 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingLocalIPAssociateV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingLocalIPAssociateV2Create,
+		ReadContext:   resourceNetworkingLocalIPAssociateV2Read,
+		DeleteContext: resourceNetworkingLocalIPAssociateV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"local_ip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"fixed_port_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"fixed_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"local_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingLocalIPAssociateV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	localIPID := d.Get("local_ip_id").(string)
+	createOpts := localIPV2PortAssociationCreateOpts{
+		FixedPortID: d.Get("fixed_port_id").(string),
+		FixedIP:     d.Get("fixed_ip").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_local_ip_associate_v2 create options: %#v", createOpts)
+	a, err := localIPV2PortAssociationCreate(networkingClient, localIPID, createOpts)
+	if err != nil {
+		return diag.Errorf("Error associating openstack_networking_local_ip_v2 %s with port %s: %s", localIPID, createOpts.FixedPortID, err)
+	}
+
+	id := resourceNetworkingLocalIPAssociateV2BuildID(localIPID, a.FixedPortID)
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Created openstack_networking_local_ip_associate_v2 %s: %#v", id, a)
+
+	return resourceNetworkingLocalIPAssociateV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingLocalIPAssociateV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	localIPID, fixedPortID, err := resourceNetworkingLocalIPAssociateV2ParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading openstack_networking_local_ip_associate_v2 ID %s: %s", d.Id(), err)
+	}
+
+	a, err := localIPV2PortAssociationGet(networkingClient, localIPID, fixedPortID)
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_local_ip_associate_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_local_ip_associate_v2 %s: %#v", d.Id(), a)
+
+	d.Set("local_ip_id", localIPID)
+	d.Set("fixed_port_id", a.FixedPortID)
+	d.Set("fixed_ip", a.FixedIP)
+	d.Set("local_ip_address", a.LocalIPAddress)
+	d.Set("host", a.Host)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingLocalIPAssociateV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	localIPID, fixedPortID, err := resourceNetworkingLocalIPAssociateV2ParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading openstack_networking_local_ip_associate_v2 ID %s: %s", d.Id(), err)
+	}
+
+	if err := localIPV2PortAssociationDelete(networkingClient, localIPID, fixedPortID); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_local_ip_associate_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingLocalIPV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingLocalIPV2Create,
+		ReadContext:   resourceNetworkingLocalIPV2Read,
+		UpdateContext: resourceNetworkingLocalIPV2Update,
+		DeleteContext: resourceNetworkingLocalIPV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"local_port_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"local_port_id", "network_id"},
+			},
+
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"local_ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"ip_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"translate", "passthrough",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceNetworkingLocalIPV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := localIPV2CreateOpts{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		ProjectID:      d.Get("project_id").(string),
+		LocalPortID:    d.Get("local_port_id").(string),
+		NetworkID:      d.Get("network_id").(string),
+		LocalIPAddress: d.Get("local_ip_address").(string),
+		IPMode:         d.Get("ip_mode").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_local_ip_v2 create options: %#v", createOpts)
+	l, err := localIPV2Create(networkingClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_local_ip_v2: %s", err)
+	}
+
+	d.SetId(l.ID)
+
+	log.Printf("[DEBUG] Created openstack_networking_local_ip_v2 %s: %#v", l.ID, l)
+
+	return resourceNetworkingLocalIPV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingLocalIPV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	l, err := localIPV2Get(networkingClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_local_ip_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_local_ip_v2 %s: %#v", d.Id(), l)
+
+	d.Set("name", l.Name)
+	d.Set("description", l.Description)
+	d.Set("project_id", l.ProjectID)
+	d.Set("local_port_id", l.LocalPortID)
+	d.Set("network_id", l.NetworkID)
+	d.Set("local_ip_address", l.LocalIPAddress)
+	d.Set("ip_mode", l.IPMode)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingLocalIPV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts localIPV2UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_networking_local_ip_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err = localIPV2Update(networkingClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_networking_local_ip_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingLocalIPV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingLocalIPV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := localIPV2Delete(networkingClient, d.Id()); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_local_ip_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNetworkingV2LocalIP_basic(t *testing.T) {
+	var localIP localIPV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccSkipReleasesBelow(t, "stable/yoga")
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2LocalIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LocalIPBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2LocalIPExists("openstack_networking_local_ip_v2.local_ip_1", &localIP),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "name", "local_ip_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "ip_mode", "translate"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_local_ip_v2.local_ip_1", "local_ip_address"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2LocalIPUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2LocalIPExists("openstack_networking_local_ip_v2.local_ip_1", &localIP),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "name", "local_ip_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_local_ip_v2.local_ip_1", "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2LocalIPAssociate_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccSkipReleasesBelow(t, "stable/yoga")
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2LocalIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2LocalIPAssociateBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_local_ip_associate_v2.associate_1", "local_ip_address",
+						"openstack_networking_local_ip_v2.local_ip_1", "local_ip_address"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_local_ip_associate_v2.associate_1", "fixed_port_id",
+						"openstack_networking_port_v2.port_2", "id"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_local_ip_associate_v2.associate_1", "fixed_ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2LocalIPDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.NetworkingV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_local_ip_v2" {
+			continue
+		}
+
+		_, err := localIPV2Get(networkingClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Local IP still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2LocalIPExists(n string, localIP *localIPV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := localIPV2Get(networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Local IP not found")
+		}
+
+		*localIP = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2LocalIPBase = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  network_id = openstack_networking_network_v2.network_1.id
+}
+`
+
+var testAccNetworkingV2LocalIPBasic = fmt.Sprintf(`
+%s
+
+resource "openstack_networking_local_ip_v2" "local_ip_1" {
+  name = "local_ip_1"
+  network_id = openstack_networking_subnet_v2.subnet_1.network_id
+  ip_mode = "translate"
+}
+`, testAccNetworkingV2LocalIPBase)
+
+var testAccNetworkingV2LocalIPUpdate = fmt.Sprintf(`
+%s
+
+resource "openstack_networking_local_ip_v2" "local_ip_1" {
+  name = "local_ip_2"
+  description = "updated"
+  network_id = openstack_networking_subnet_v2.subnet_1.network_id
+  ip_mode = "translate"
+}
+`, testAccNetworkingV2LocalIPBase)
+
+var testAccNetworkingV2LocalIPAssociateBasic = fmt.Sprintf(`
+%s
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  network_id = openstack_networking_subnet_v2.subnet_1.network_id
+}
+
+resource "openstack_networking_port_v2" "port_2" {
+  name = "port_2"
+  network_id = openstack_networking_subnet_v2.subnet_1.network_id
+}
+
+resource "openstack_networking_local_ip_v2" "local_ip_1" {
+  name = "local_ip_1"
+  local_port_id = openstack_networking_port_v2.port_1.id
+}
+
+resource "openstack_networking_local_ip_associate_v2" "associate_1" {
+  local_ip_id = openstack_networking_local_ip_v2.local_ip_1.id
+  fixed_port_id = openstack_networking_port_v2.port_2.id
+}
+`, testAccNetworkingV2LocalIPBase)
@@ -0,0 +1,166 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingNDPProxyV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingNDPProxyV2Create,
+		ReadContext:   resourceNetworkingNDPProxyV2Read,
+		UpdateContext: resourceNetworkingNDPProxyV2Update,
+		DeleteContext: resourceNetworkingNDPProxyV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"router_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"port_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv6Address,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingNDPProxyV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := ndpProxyV2CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		RouterID:    d.Get("router_id").(string),
+		PortID:      d.Get("port_id").(string),
+		IPAddress:   d.Get("ip_address").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_ndp_proxy_v2 create options: %#v", createOpts)
+	p, err := ndpProxyV2Create(networkingClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_ndp_proxy_v2: %s", err)
+	}
+
+	d.SetId(p.ID)
+
+	log.Printf("[DEBUG] Created openstack_networking_ndp_proxy_v2 %s: %#v", p.ID, p)
+
+	return resourceNetworkingNDPProxyV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingNDPProxyV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	p, err := ndpProxyV2Get(networkingClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_ndp_proxy_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_ndp_proxy_v2 %s: %#v", d.Id(), p)
+
+	d.Set("name", p.Name)
+	d.Set("description", p.Description)
+	d.Set("router_id", p.RouterID)
+	d.Set("port_id", p.PortID)
+	d.Set("ip_address", p.IPAddress)
+	d.Set("project_id", p.ProjectID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingNDPProxyV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts ndpProxyV2UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_networking_ndp_proxy_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err = ndpProxyV2Update(networkingClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_networking_ndp_proxy_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingNDPProxyV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingNDPProxyV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := ndpProxyV2Delete(networkingClient, d.Id()); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_ndp_proxy_v2"))
+	}
+
+	return nil
+}
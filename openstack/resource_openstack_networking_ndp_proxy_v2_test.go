@@ -0,0 +1,176 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNetworkingV2NDPProxy_basic(t *testing.T) {
+	var proxy ndpProxyV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccSkipReleasesBelow(t, "stable/zed")
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2NDPProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2NDPProxyBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NDPProxyExists("openstack_networking_ndp_proxy_v2.proxy_1", &proxy),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_ndp_proxy_v2.proxy_1", "name", "proxy_1"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_ndp_proxy_v2.proxy_1", "ip_address"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2NDPProxyUpdate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NDPProxyExists("openstack_networking_ndp_proxy_v2.proxy_1", &proxy),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_ndp_proxy_v2.proxy_1", "name", "proxy_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_ndp_proxy_v2.proxy_1", "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2NDPProxyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.NetworkingV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_ndp_proxy_v2" {
+			continue
+		}
+
+		_, err := ndpProxyV2Get(networkingClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("NDP proxy still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2NDPProxyExists(n string, proxy *ndpProxyV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := ndpProxyV2Get(networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("NDP proxy not found")
+		}
+
+		*proxy = *found
+
+		return nil
+	}
+}
+
+func testAccNetworkingV2NDPProxyBase() string {
+	return fmt.Sprintf(`
+resource "openstack_networking_addressscope_v2" "addressscope_1" {
+  name = "addressscope_1"
+  ip_version = 6
+}
+
+resource "openstack_networking_subnetpool_v2" "subnetpool_1" {
+  name = "subnetpool_1"
+  prefixes = ["fd00:10::/56"]
+  address_scope_id = openstack_networking_addressscope_v2.addressscope_1.id
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = openstack_networking_network_v2.network_1.id
+  subnetpool_id = openstack_networking_subnetpool_v2.subnetpool_1.id
+  ip_version = 6
+  ipv6_address_mode = "slaac"
+  ipv6_ra_mode = "slaac"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+  external_network_id = "%s"
+
+  value_specs = {
+    enable_ndp_proxy = "true"
+  }
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = openstack_networking_router_v2.router_1.id
+  subnet_id = openstack_networking_subnet_v2.subnet_1.id
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  network_id = openstack_networking_network_v2.network_1.id
+
+  fixed_ip {
+    subnet_id = openstack_networking_subnet_v2.subnet_1.id
+  }
+}
+`, osExtGwID)
+}
+
+func testAccNetworkingV2NDPProxyBasic() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_networking_ndp_proxy_v2" "proxy_1" {
+  name = "proxy_1"
+  router_id = openstack_networking_router_interface_v2.router_interface_1.router_id
+  port_id = openstack_networking_port_v2.port_1.id
+}
+`, testAccNetworkingV2NDPProxyBase())
+}
+
+func testAccNetworkingV2NDPProxyUpdate() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_networking_ndp_proxy_v2" "proxy_1" {
+  name = "proxy_2"
+  description = "updated"
+  router_id = openstack_networking_router_interface_v2.router_interface_1.router_id
+  port_id = openstack_networking_port_v2.port_1.id
+}
+`, testAccNetworkingV2NDPProxyBase())
+}
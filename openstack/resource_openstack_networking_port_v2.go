@@ -230,8 +230,8 @@ func resourceNetworkingPortV2() *schema.Resource {
 						"profile": {
 							Type:             schema.TypeString,
 							Optional:         true,
-							ValidateFunc:     validateJSONObject,
-							DiffSuppressFunc: diffSuppressJSONObject,
+							ValidateFunc:     validateNetworkingPortV2BindingProfile,
+							DiffSuppressFunc: diffSuppressNetworkingPortV2BindingProfile,
 							StateFunc: func(v interface{}) string {
 								json, _ := structure.NormalizeJsonString(v)
 								return json
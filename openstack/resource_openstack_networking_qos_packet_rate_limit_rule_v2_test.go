@@ -0,0 +1,154 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
+)
+
+func TestAccNetworkingV2QoSPacketRateLimitRule_basic(t *testing.T) {
+	var (
+		policy policies.Policy
+		rule   networkingQoSPacketRateLimitRuleV2
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccSkipReleasesBelow(t, "stable/yoga")
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2QoSPacketRateLimitRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2QoSPacketRateLimitRuleBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2QoSPolicyExists(
+						"openstack_networking_qos_policy_v2.qos_policy_1", &policy),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_policy_v2.qos_policy_1", "name", "qos_policy_1"),
+					testAccCheckNetworkingV2QoSPacketRateLimitRuleExists(
+						"openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", &rule),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", "max_kpps", "3000"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", "max_burst_kpps", "300"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", "direction", "egress"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2QoSPacketRateLimitRuleUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2QoSPolicyExists(
+						"openstack_networking_qos_policy_v2.qos_policy_1", &policy),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_policy_v2.qos_policy_1", "name", "qos_policy_1"),
+					testAccCheckNetworkingV2QoSPacketRateLimitRuleExists(
+						"openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", &rule),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", "max_kpps", "2000"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", "max_burst_kpps", "100"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_packet_rate_limit_rule_v2.pps_limit_rule_1", "direction", "ingress"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2QoSPacketRateLimitRuleExists(n string, rule *networkingQoSPacketRateLimitRuleV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		qosPolicyID, qosRuleID, err := resourceNetworkingQoSRuleV2ParseID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error reading openstack_networking_qos_packet_rate_limit_rule_v2 ID %s: %s", rs.Primary.ID, err)
+		}
+
+		found, err := networkingQoSPacketRateLimitRuleV2Get(networkingClient, qosPolicyID, qosRuleID)
+		if err != nil {
+			return err
+		}
+
+		foundID := resourceNetworkingQoSRuleV2BuildID(qosPolicyID, found.ID)
+
+		if foundID != rs.Primary.ID {
+			return fmt.Errorf("QoS packet rate limit rule not found")
+		}
+
+		*rule = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2QoSPacketRateLimitRuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.NetworkingV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_qos_packet_rate_limit_rule_v2" {
+			continue
+		}
+
+		qosPolicyID, qosRuleID, err := resourceNetworkingQoSRuleV2ParseID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error reading openstack_networking_qos_packet_rate_limit_rule_v2 ID %s: %s", rs.Primary.ID, err)
+		}
+
+		_, err = networkingQoSPacketRateLimitRuleV2Get(networkingClient, qosPolicyID, qosRuleID)
+		if err == nil {
+			return fmt.Errorf("QoS rule still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkingV2QoSPacketRateLimitRuleBasic = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_packet_rate_limit_rule_v2" "pps_limit_rule_1" {
+  qos_policy_id  = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  max_kpps       = 3000
+  max_burst_kpps = 300
+}
+`
+
+const testAccNetworkingV2QoSPacketRateLimitRuleUpdate = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_packet_rate_limit_rule_v2" "pps_limit_rule_1" {
+  qos_policy_id  = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  max_kpps       = 2000
+  max_burst_kpps = 100
+  direction      = "ingress"
+}
+`
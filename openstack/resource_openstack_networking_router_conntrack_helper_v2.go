@@ -0,0 +1,172 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkingRouterConntrackHelperV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingRouterConntrackHelperV2Create,
+		ReadContext:   resourceNetworkingRouterConntrackHelperV2Read,
+		UpdateContext: resourceNetworkingRouterConntrackHelperV2Update,
+		DeleteContext: resourceNetworkingRouterConntrackHelperV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"router_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"tcp", "udp", "icmp", "ipv6-icmp", "dccp", "sctp", "gre",
+				}, false),
+			},
+
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+
+			"helper": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingRouterConntrackHelperV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID := d.Get("router_id").(string)
+	createOpts := routerConntrackHelperV2CreateOpts{
+		Protocol: d.Get("protocol").(string),
+		Port:     d.Get("port").(int),
+		Helper:   d.Get("helper").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_networking_router_conntrack_helper_v2 create options: %#v", createOpts)
+	h, err := routerConntrackHelperV2Create(networkingClient, routerID, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_networking_router_conntrack_helper_v2: %s", err)
+	}
+
+	id := resourceNetworkingRouterConntrackHelperV2BuildID(routerID, h.ID)
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Created openstack_networking_router_conntrack_helper_v2 %s: %#v", id, h)
+
+	return resourceNetworkingRouterConntrackHelperV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingRouterConntrackHelperV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID, helperID, err := resourceNetworkingRouterConntrackHelperV2ParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading openstack_networking_router_conntrack_helper_v2 ID %s: %s", d.Id(), err)
+	}
+
+	h, err := routerConntrackHelperV2Get(networkingClient, routerID, helperID)
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_router_conntrack_helper_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_router_conntrack_helper_v2 %s: %#v", d.Id(), h)
+
+	d.Set("router_id", routerID)
+	d.Set("protocol", h.Protocol)
+	d.Set("port", h.Port)
+	d.Set("helper", h.Helper)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingRouterConntrackHelperV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID, helperID, err := resourceNetworkingRouterConntrackHelperV2ParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading openstack_networking_router_conntrack_helper_v2 ID %s: %s", d.Id(), err)
+	}
+
+	var hasChange bool
+	var updateOpts routerConntrackHelperV2UpdateOpts
+
+	if d.HasChange("protocol") {
+		hasChange = true
+		updateOpts.Protocol = d.Get("protocol").(string)
+	}
+
+	if d.HasChange("port") {
+		hasChange = true
+		updateOpts.Port = d.Get("port").(int)
+	}
+
+	if d.HasChange("helper") {
+		hasChange = true
+		updateOpts.Helper = d.Get("helper").(string)
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_networking_router_conntrack_helper_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err = routerConntrackHelperV2Update(networkingClient, routerID, helperID, updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_networking_router_conntrack_helper_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingRouterConntrackHelperV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingRouterConntrackHelperV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID, helperID, err := resourceNetworkingRouterConntrackHelperV2ParseID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error reading openstack_networking_router_conntrack_helper_v2 ID %s: %s", d.Id(), err)
+	}
+
+	if err := routerConntrackHelperV2Delete(networkingClient, routerID, helperID); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_networking_router_conntrack_helper_v2"))
+	}
+
+	return nil
+}
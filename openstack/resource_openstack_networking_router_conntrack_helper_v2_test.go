@@ -0,0 +1,142 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNetworkingV2RouterConntrackHelper_basic(t *testing.T) {
+	var helper routerConntrackHelperV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccSkipReleasesBelow(t, "stable/ussuri")
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2RouterConntrackHelperDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2RouterConntrackHelperBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterConntrackHelperExists(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", &helper),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", "protocol", "udp"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", "port", "69"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", "helper", "tftp"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2RouterConntrackHelperUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterConntrackHelperExists(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", &helper),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", "protocol", "tcp"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", "port", "21"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", "helper", "ftp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2RouterConntrackHelperDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.NetworkingV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_router_conntrack_helper_v2" {
+			continue
+		}
+
+		routerID, helperID, err := resourceNetworkingRouterConntrackHelperV2ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = routerConntrackHelperV2Get(networkingClient, routerID, helperID)
+		if err == nil {
+			return fmt.Errorf("Router conntrack helper still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2RouterConntrackHelperExists(n string, helper *routerConntrackHelperV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		routerID, helperID, err := resourceNetworkingRouterConntrackHelperV2ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := routerConntrackHelperV2Get(networkingClient, routerID, helperID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != helperID {
+			return fmt.Errorf("Router conntrack helper not found")
+		}
+
+		*helper = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2RouterConntrackHelperBasic = `
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_router_conntrack_helper_v2" "helper_1" {
+  router_id = openstack_networking_router_v2.router_1.id
+  protocol = "udp"
+  port = 69
+  helper = "tftp"
+}
+`
+
+const testAccNetworkingV2RouterConntrackHelperUpdate = `
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_router_conntrack_helper_v2" "helper_1" {
+  router_id = openstack_networking_router_v2.router_1.id
+  protocol = "tcp"
+  port = 21
+  helper = "ftp"
+}
+`
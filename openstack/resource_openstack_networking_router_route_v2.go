@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/extraroutes"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
 )
 
@@ -56,46 +57,29 @@ func resourceNetworkingRouterRouteV2Create(ctx context.Context, d *schema.Resour
 	}
 
 	routerID := d.Get("router_id").(string)
-	config.MutexKV.Lock(routerID)
-	defer config.MutexKV.Unlock(routerID)
-
-	r, err := routers.Get(networkingClient, routerID).Extract()
-	if err != nil {
-		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_router_v2"))
-	}
-
-	log.Printf("[DEBUG] Retrieved openstack_networking_router_v2 %s: %#v", routerID, r)
-
-	routes := r.Routes
 	dstCIDR := d.Get("destination_cidr").(string)
 	nextHop := d.Get("next_hop").(string)
-	exists := false
 
-	for _, route := range routes {
-		if route.DestinationCIDR == dstCIDR && route.NextHop == nextHop {
-			exists = true
-			break
-		}
-	}
-
-	if exists {
-		log.Printf("[DEBUG] openstack_networking_router_v2 %s already has route to %s via %s", routerID, dstCIDR, nextHop)
-		return resourceNetworkingRouterRouteV2Read(ctx, d, meta)
+	// add_extraroutes is atomic on the Neutron side and a no-op for routes,
+	// which already exist, so no read-modify-write or locking is needed.
+	routes := []routers.Route{
+		{
+			DestinationCIDR: dstCIDR,
+			NextHop:         nextHop,
+		},
 	}
-
-	routes = append(routes, routers.Route{
-		DestinationCIDR: dstCIDR,
-		NextHop:         nextHop,
-	})
-	updateOpts := routers.UpdateOpts{
+	addOpts := extraroutes.Opts{
 		Routes: &routes,
 	}
-	log.Printf("[DEBUG] openstack_networking_router_v2 %s update options: %#v", routerID, updateOpts)
-	_, err = routers.Update(networkingClient, routerID, updateOpts).Extract()
+
+	log.Printf("[DEBUG] openstack_networking_router_v2 %s add extra routes options: %#v", routerID, addOpts)
+	r, err := extraroutes.Add(networkingClient, routerID, addOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error updating openstack_networking_router_v2: %s", err)
+		return diag.Errorf("Error adding route to openstack_networking_router_v2 %s: %s", routerID, err)
 	}
 
+	log.Printf("[DEBUG] Added route to %s via %s to openstack_networking_router_v2 %s: %#v", dstCIDR, nextHop, routerID, r)
+
 	d.SetId(resourceNetworkingRouterRouteV2BuildID(routerID, dstCIDR, nextHop))
 
 	return resourceNetworkingRouterRouteV2Read(ctx, d, meta)
@@ -147,39 +131,23 @@ func resourceNetworkingRouterRouteV2Delete(ctx context.Context, d *schema.Resour
 	}
 
 	routerID := d.Get("router_id").(string)
-	config.MutexKV.Lock(routerID)
-	defer config.MutexKV.Unlock(routerID)
-
-	r, err := routers.Get(networkingClient, routerID).Extract()
-	if err != nil {
-		return diag.FromErr(CheckDeleted(d, err, "Error getting openstack_networking_router_v2"))
-	}
-
-	log.Printf("[DEBUG] Retrieved openstack_networking_router_v2 %s: %#v", routerID, r)
-
 	dstCIDR := d.Get("destination_cidr").(string)
 	nextHop := d.Get("next_hop").(string)
 
-	oldRoutes := r.Routes
-	newRoute := []routers.Route{}
-
-	for _, route := range oldRoutes {
-		if route.DestinationCIDR != dstCIDR || route.NextHop != nextHop {
-			newRoute = append(newRoute, route)
-		}
+	routes := []routers.Route{
+		{
+			DestinationCIDR: dstCIDR,
+			NextHop:         nextHop,
+		},
 	}
-
-	if len(oldRoutes) == len(newRoute) {
-		return diag.Errorf("Can't find route to %s via %s on openstack_networking_router_v2 %s", dstCIDR, nextHop, routerID)
+	removeOpts := extraroutes.Opts{
+		Routes: &routes,
 	}
 
 	log.Printf("[DEBUG] Deleting openstack_networking_router_v2 %s route to %s via %s", routerID, dstCIDR, nextHop)
-	updateOpts := routers.UpdateOpts{
-		Routes: &newRoute,
-	}
-	_, err = routers.Update(networkingClient, routerID, updateOpts).Extract()
+	_, err = extraroutes.Remove(networkingClient, routerID, removeOpts).Extract()
 	if err != nil {
-		return diag.Errorf("Error updating openstack_networking_router_v2: %s", err)
+		return diag.FromErr(CheckDeleted(d, err, "Error removing route from openstack_networking_router_v2"))
 	}
 
 	return nil
@@ -3,11 +3,13 @@ package openstack
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
@@ -60,6 +62,73 @@ func resourceNetworkingSecGroupV2() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"rule": {
+				Type:       schema.TypeSet,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Set:        networkingSecgroupV2RuleHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"direction": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ingress", "egress"}, false),
+						},
+
+						"ethertype": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"IPv4", "IPv6"}, false),
+						},
+
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"port_range_min": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"port_range_max": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"remote_ip_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							StateFunc: func(v interface{}) string {
+								return strings.ToLower(v.(string))
+							},
+						},
+
+						"remote_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"self": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -112,6 +181,24 @@ func resourceNetworkingSecGroupV2Create(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(sg.ID)
 
+	// Converge the rules of the new security group to the inline rules,
+	// if they were specified, including an explicitly empty list.
+	if !d.GetRawConfig().GetAttr("rule").IsNull() {
+		v := d.Get("rule")
+		config.MutexKV.Lock(sg.ID)
+		defer config.MutexKV.Unlock(sg.ID)
+
+		current, err := groups.Get(networkingClient, sg.ID).Extract()
+		if err != nil {
+			return diag.Errorf("Error retrieving the created openstack_networking_secgroup_v2 %s: %s", sg.ID, err)
+		}
+
+		currentRules := schema.NewSet(networkingSecgroupV2RuleHash, flattenNetworkingSecgroupV2Rules(sg.ID, current.Rules))
+		if err := networkingSecgroupV2UpdateRules(networkingClient, sg.ID, currentRules, v.(*schema.Set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	tags := networkingV2AttributesTags(d)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
@@ -144,6 +231,10 @@ func resourceNetworkingSecGroupV2Read(ctx context.Context, d *schema.ResourceDat
 	d.Set("name", sg.Name)
 	d.Set("region", GetRegion(d, config))
 
+	if err := d.Set("rule", flattenNetworkingSecgroupV2Rules(sg.ID, sg.Rules)); err != nil {
+		return diag.Errorf("Unable to set openstack_networking_secgroup_v2 %s rules: %s", d.Id(), err)
+	}
+
 	networkingV2ReadAttributesTags(d, sg.Tags)
 
 	return nil
@@ -180,6 +271,16 @@ func resourceNetworkingSecGroupV2Update(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if d.HasChange("rule") {
+		config.MutexKV.Lock(d.Id())
+		defer config.MutexKV.Unlock(d.Id())
+
+		oldRules, newRules := d.GetChange("rule")
+		if err := networkingSecgroupV2UpdateRules(networkingClient, d.Id(), oldRules.(*schema.Set), newRules.(*schema.Set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("tags") {
 		tags := networkingV2UpdateAttributesTags(d)
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
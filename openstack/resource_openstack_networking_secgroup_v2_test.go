@@ -84,6 +84,51 @@ func TestAccNetworkingV2SecGroup_timeout(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2SecGroup_rules(t *testing.T) {
+	var securityGroup groups.SecGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRules,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"openstack_networking_secgroup_v2.secgroup_1", &securityGroup),
+					testAccCheckNetworkingV2SecGroupRuleCount(&securityGroup, 3),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_secgroup_v2.secgroup_1", "rule.#", "3"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SecGroupRulesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"openstack_networking_secgroup_v2.secgroup_1", &securityGroup),
+					testAccCheckNetworkingV2SecGroupRuleCount(&securityGroup, 2),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_secgroup_v2.secgroup_1", "rule.#", "2"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SecGroupRulesEmpty,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"openstack_networking_secgroup_v2.secgroup_1", &securityGroup),
+					testAccCheckNetworkingV2SecGroupRuleCount(&securityGroup, 0),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_secgroup_v2.secgroup_1", "rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2SecGroupDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.NetworkingV2Client(osRegionName)
@@ -180,3 +225,64 @@ resource "openstack_networking_secgroup_v2" "secgroup_1" {
   }
 }
 `
+
+const testAccNetworkingV2SecGroupRules = `
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "security_group_1"
+  description = "terraform security group acceptance test"
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    protocol = "tcp"
+    port_range_min = 22
+    port_range_max = 22
+    remote_ip_prefix = "192.168.199.0/24"
+  }
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    protocol = "tcp"
+    port_range_min = 80
+    port_range_max = 80
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    self = true
+  }
+}
+`
+
+const testAccNetworkingV2SecGroupRulesUpdate = `
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "security_group_1"
+  description = "terraform security group acceptance test"
+
+  rule {
+    direction = "ingress"
+    ethertype = "IPv4"
+    protocol = "tcp"
+    port_range_min = 443
+    port_range_max = 443
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+
+  rule {
+    direction = "egress"
+    ethertype = "IPv4"
+  }
+}
+`
+
+const testAccNetworkingV2SecGroupRulesEmpty = `
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "security_group_1"
+  description = "terraform security group acceptance test"
+
+  rule = []
+}
+`
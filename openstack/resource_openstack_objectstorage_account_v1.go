@@ -0,0 +1,197 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+)
+
+func resourceObjectStorageAccountV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObjectStorageAccountV1Create,
+		ReadContext:   resourceObjectStorageAccountV1Read,
+		UpdateContext: resourceObjectStorageAccountV1Update,
+		DeleteContext: resourceObjectStorageAccountV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"temp_url_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"temp_url_key_2": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"bytes_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"container_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"quota_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceObjectStorageAccountV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating OpenStack object storage client: %s", err)
+	}
+
+	updateOpts := accounts.UpdateOpts{
+		Metadata:    expandToMapStringString(d.Get("metadata").(map[string]interface{})),
+		TempURLKey:  d.Get("temp_url_key").(string),
+		TempURLKey2: d.Get("temp_url_key_2").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_objectstorage_account_v1 create options: %#v", updateOpts)
+	_, err = accounts.Update(objectStorageClient, updateOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating openstack_objectstorage_account_v1: %s", err)
+	}
+
+	d.SetId(objectStorageAccountV1Name(objectStorageClient.Endpoint))
+
+	return resourceObjectStorageAccountV1Read(ctx, d, meta)
+}
+
+func resourceObjectStorageAccountV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating OpenStack object storage client: %s", err)
+	}
+
+	result := accounts.Get(objectStorageClient, nil)
+	if result.Err != nil {
+		return diag.FromErr(CheckDeleted(d, result.Err, "error retrieving openstack_objectstorage_account_v1"))
+	}
+
+	headers, err := result.Extract()
+	if err != nil {
+		return diag.Errorf("error extracting headers for openstack_objectstorage_account_v1 '%s': %s", d.Id(), err)
+	}
+	log.Printf("[DEBUG] Retrieved headers for openstack_objectstorage_account_v1 '%s': %#v", d.Id(), headers)
+
+	metadata, err := result.ExtractMetadata()
+	if err != nil {
+		return diag.Errorf("error extracting metadata for openstack_objectstorage_account_v1 '%s': %s", d.Id(), err)
+	}
+
+	d.Set("metadata", flattenObjectStorageAccountV1Metadata(metadata, d.Get("metadata").(map[string]interface{})))
+	d.Set("temp_url_key", headers.TempURLKey)
+	d.Set("temp_url_key_2", headers.TempURLKey2)
+	d.Set("bytes_used", headers.BytesUsed)
+	d.Set("container_count", headers.ContainerCount)
+	d.Set("object_count", headers.ObjectCount)
+	if headers.QuotaBytes != nil {
+		d.Set("quota_bytes", *headers.QuotaBytes)
+	} else {
+		d.Set("quota_bytes", 0)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceObjectStorageAccountV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating OpenStack object storage client: %s", err)
+	}
+
+	var updateOpts accounts.UpdateOpts
+
+	if d.HasChange("metadata") {
+		o, n := d.GetChange("metadata")
+		updateOpts.Metadata = expandToMapStringString(n.(map[string]interface{}))
+		updateOpts.RemoveMetadata = objectStorageAccountV1RemovedMetadata(o.(map[string]interface{}), n.(map[string]interface{}))
+	}
+
+	if d.HasChange("temp_url_key") {
+		if v := d.Get("temp_url_key").(string); v != "" {
+			updateOpts.TempURLKey = v
+		} else {
+			updateOpts.RemoveMetadata = append(updateOpts.RemoveMetadata, "Temp-URL-Key")
+		}
+	}
+
+	if d.HasChange("temp_url_key_2") {
+		if v := d.Get("temp_url_key_2").(string); v != "" {
+			updateOpts.TempURLKey2 = v
+		} else {
+			updateOpts.RemoveMetadata = append(updateOpts.RemoveMetadata, "Temp-URL-Key-2")
+		}
+	}
+
+	log.Printf("[DEBUG] openstack_objectstorage_account_v1 '%s' update options: %#v", d.Id(), updateOpts)
+	_, err = accounts.Update(objectStorageClient, updateOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error updating openstack_objectstorage_account_v1 '%s': %s", d.Id(), err)
+	}
+
+	return resourceObjectStorageAccountV1Read(ctx, d, meta)
+}
+
+func resourceObjectStorageAccountV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("error creating OpenStack object storage client: %s", err)
+	}
+
+	// An account can't be deleted, so only the metadata and the temporary URL
+	// keys are removed.
+	removeMetadata := objectStorageAccountV1RemovedMetadata(d.Get("metadata").(map[string]interface{}), nil)
+	removeMetadata = append(removeMetadata, "Temp-URL-Key", "Temp-URL-Key-2")
+
+	updateOpts := accounts.UpdateOpts{
+		RemoveMetadata: removeMetadata,
+	}
+
+	_, err = accounts.Update(objectStorageClient, updateOpts).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "error deleting openstack_objectstorage_account_v1"))
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+)
+
+func TestAccObjectStorageV1Account_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckObjectStorageV1AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1AccountBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.test", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.upperTest", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "temp_url_key", "secret"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_objectstorage_account_v1.account_1", "container_count"),
+				),
+			},
+			{
+				Config: testAccObjectStorageV1AccountUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.test", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "temp_url_key", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "temp_url_key_2", "secret2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStorageV1AccountDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_objectstorage_account_v1" {
+			continue
+		}
+
+		result := accounts.Get(objectStorageClient, nil)
+		headers, err := result.Extract()
+		if err != nil {
+			return err
+		}
+
+		if headers.TempURLKey != "" || headers.TempURLKey2 != "" {
+			return fmt.Errorf("Account temp URL keys still exist")
+		}
+
+		metadata, err := result.ExtractMetadata()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := metadata["Test"]; ok {
+			return fmt.Errorf("Account metadata still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccObjectStorageV1AccountBasic = `
+resource "openstack_objectstorage_account_v1" "account_1" {
+  metadata = {
+    test = "true"
+    upperTest = "true"
+  }
+  temp_url_key = "secret"
+}
+`
+
+const testAccObjectStorageV1AccountUpdate = `
+resource "openstack_objectstorage_account_v1" "account_1" {
+  metadata = {
+    test = "false"
+  }
+  temp_url_key_2 = "secret2"
+}
+`
@@ -35,6 +35,8 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: resourceObjectStorageContainerV1TypedMetadataDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -116,6 +118,38 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"web_index": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"web_error": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"web_listings": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"web_listings_css": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"quota_bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"quota_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 	}
 }
@@ -140,6 +174,11 @@ func resourceObjectStorageContainerV1Create(ctx context.Context, d *schema.Resou
 		Metadata:         resourceContainerMetadataV2(d),
 	}
 
+	typedMetadata, _ := expandObjectStorageContainerV1TypedMetadata(d, false)
+	for k, v := range typedMetadata {
+		createOpts.Metadata[k] = v
+	}
+
 	versioning := d.Get("versioning_legacy").(*schema.Set)
 	if versioning.Len() > 0 {
 		vParams := versioning.List()[0]
@@ -234,6 +273,8 @@ func resourceObjectStorageContainerV1Read(ctx context.Context, d *schema.Resourc
 	d.Set("versioning", headers.VersionsEnabled)
 	d.Set("region", GetRegion(d, config))
 
+	flattenObjectStorageContainerV1TypedMetadata(d, metadata)
+
 	return nil
 }
 
@@ -314,6 +355,15 @@ func resourceObjectStorageContainerV1Update(ctx context.Context, d *schema.Resou
 		updateOpts.Metadata = resourceContainerMetadataV2(d)
 	}
 
+	typedMetadata, removeMetadata := expandObjectStorageContainerV1TypedMetadata(d, true)
+	if len(typedMetadata) > 0 && updateOpts.Metadata == nil {
+		updateOpts.Metadata = make(map[string]string)
+	}
+	for k, v := range typedMetadata {
+		updateOpts.Metadata[k] = v
+	}
+	updateOpts.RemoveMetadata = removeMetadata
+
 	_, err = containers.Update(objectStorageClient, d.Id(), updateOpts).Extract()
 	if err != nil {
 		return diag.Errorf("error updating objectstorage_container_v1 '%s': %s", d.Id(), err)
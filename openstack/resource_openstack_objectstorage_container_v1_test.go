@@ -102,6 +102,90 @@ func TestAccObjectStorageV1Container_storagePolicy(t *testing.T) {
 	})
 }
 
+func TestAccObjectStorageV1Container_staticWeb(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckObjectStorageV1ContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ContainerStaticWeb,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "web_index", "index.html"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "web_error", "error.html"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "web_listings", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_bytes", "1048576"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_count", "100"),
+				),
+			},
+			{
+				Config: testAccObjectStorageV1ContainerStaticWebUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "web_index", "main.html"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "web_error", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "web_listings", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_bytes", "0"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_count", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectStorageV1Container_staticWebMetadata(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckObjectStorageV1ContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ContainerStaticWebMetadata,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "web_index", "index.html"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_count", "100"),
+				),
+			},
+			{
+				// Static web and quota settings in the metadata map of an
+				// existing configuration must not produce a diff.
+				Config:   testAccObjectStorageV1ContainerStaticWebMetadata,
+				PlanOnly: true,
+			},
+			{
+				Config: testAccObjectStorageV1ContainerStaticWebMetadataUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "web_index", "index.html"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "web_error", "error.html"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_count", "100"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectStorageV1ContainerDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	objectStorageClient, err := config.ObjectStorageV1Client(osRegionName)
@@ -186,3 +270,47 @@ resource "openstack_objectstorage_container_v1" "container_1" {
   storage_policy = "Policy-0"
 }
 `
+
+const testAccObjectStorageV1ContainerStaticWeb = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+  container_read = ".r:*,.rlistings"
+  web_index = "index.html"
+  web_error = "error.html"
+  web_listings = true
+  quota_bytes = 1048576
+  quota_count = 100
+}
+`
+
+const testAccObjectStorageV1ContainerStaticWebUpdate = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+  container_read = ".r:*,.rlistings"
+  web_index = "main.html"
+  quota_count = 200
+}
+`
+
+const testAccObjectStorageV1ContainerStaticWebMetadata = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+  container_read = ".r:*,.rlistings"
+  metadata = {
+    Web-Index = "index.html"
+    Quota-Count = "100"
+  }
+}
+`
+
+const testAccObjectStorageV1ContainerStaticWebMetadataUpdate = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+  container_read = ".r:*,.rlistings"
+  web_error = "error.html"
+  metadata = {
+    Web-Index = "index.html"
+    Quota-Count = "100"
+  }
+}
+`
@@ -0,0 +1,258 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func resourceObjectStorageObjectSyncV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObjectStorageObjectSyncV1Create,
+		ReadContext:   resourceObjectStorageObjectSyncV1Read,
+		UpdateContext: resourceObjectStorageObjectSyncV1Update,
+		DeleteContext: resourceObjectStorageObjectSyncV1Delete,
+
+		CustomizeDiff: resourceObjectStorageObjectSyncV1CustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"container_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"detect_content_type": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"delete_remote": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
+
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceObjectStorageObjectSyncV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+	prefix := d.Get("prefix").(string)
+
+	if err := resourceObjectStorageObjectSyncV1Sync(ctx, d, objectStorageClient, false); err != nil {
+		return diag.Errorf("Error syncing openstack_objectstorage_object_sync_v1 to %s/%s: %s", cn, prefix, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cn, prefix))
+
+	return resourceObjectStorageObjectSyncV1Read(ctx, d, meta)
+}
+
+func resourceObjectStorageObjectSyncV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+	prefix := d.Get("prefix").(string)
+	include := expandToStringSlice(d.Get("include").([]interface{}))
+	exclude := expandToStringSlice(d.Get("exclude").([]interface{}))
+
+	remote, err := objectStorageObjectSyncV1RemoteFiles(objectStorageClient, cn, prefix, include, exclude)
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error listing openstack_objectstorage_object_sync_v1 objects"))
+	}
+
+	// Objects, which weren't uploaded by this resource, aren't tracked.
+	remote = objectStorageObjectSyncV1Tracked(remote, d.Get("files").(map[string]interface{}))
+
+	log.Printf("[DEBUG] Retrieved openstack_objectstorage_object_sync_v1 %s files: %#v", d.Id(), remote)
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("files", remote)
+
+	return nil
+}
+
+func resourceObjectStorageObjectSyncV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	// Metadata and the content type are set per object, so all files have to
+	// be uploaded again.
+	force := d.HasChanges("metadata", "detect_content_type")
+
+	if err := resourceObjectStorageObjectSyncV1Sync(ctx, d, objectStorageClient, force); err != nil {
+		return diag.Errorf("Error syncing openstack_objectstorage_object_sync_v1 %s: %s", d.Id(), err)
+	}
+
+	return resourceObjectStorageObjectSyncV1Read(ctx, d, meta)
+}
+
+func resourceObjectStorageObjectSyncV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+	prefix := d.Get("prefix").(string)
+
+	var names []string
+	for name := range d.Get("files").(map[string]interface{}) {
+		names = append(names, name)
+	}
+
+	err = objectStorageObjectSyncV1Run(ctx, names, d.Get("parallelism").(int), func(name string) error {
+		return objectStorageObjectSyncV1Delete(objectStorageClient, cn, prefix+name)
+	})
+	if err != nil {
+		return diag.Errorf("Error deleting openstack_objectstorage_object_sync_v1 %s objects: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceObjectStorageObjectSyncV1Sync(ctx context.Context, d *schema.ResourceData, client *gophercloud.ServiceClient, force bool) error {
+	cn := d.Get("container_name").(string)
+	prefix := d.Get("prefix").(string)
+	sourceDir := d.Get("source_dir").(string)
+	include := expandToStringSlice(d.Get("include").([]interface{}))
+	exclude := expandToStringSlice(d.Get("exclude").([]interface{}))
+	parallelism := d.Get("parallelism").(int)
+	detectContentType := d.Get("detect_content_type").(bool)
+	metadata := resourceObjectMetadataV1(d)
+
+	dir, err := homedir.Expand(sourceDir)
+	if err != nil {
+		return fmt.Errorf("Error expanding homedir in source_dir (%s): %s", sourceDir, err)
+	}
+
+	local, err := objectStorageObjectSyncV1LocalFiles(sourceDir, include, exclude)
+	if err != nil {
+		return err
+	}
+
+	remote, err := objectStorageObjectSyncV1RemoteFiles(client, cn, prefix, include, exclude)
+	if err != nil {
+		return fmt.Errorf("Error listing objects: %s", err)
+	}
+
+	upload, _ := objectStorageObjectSyncV1Changes(local, remote)
+	if force {
+		upload, _ = objectStorageObjectSyncV1Changes(local, nil)
+	}
+
+	// Only objects, which were synced before, are deleted. Other objects in
+	// the container, e.g. on the first sync, are never touched.
+	tracked, _ := d.GetChange("files")
+	_, remove := objectStorageObjectSyncV1Changes(local, objectStorageObjectSyncV1Tracked(remote, tracked.(map[string]interface{})))
+
+	log.Printf("[DEBUG] Uploading %d files to %s/%s", len(upload), cn, prefix)
+	err = objectStorageObjectSyncV1Run(ctx, upload, parallelism, func(name string) error {
+		return objectStorageObjectSyncV1Upload(client, cn, prefix+name, filepath.Join(dir, filepath.FromSlash(name)), local[name], metadata, detectContentType)
+	})
+	if err != nil {
+		return fmt.Errorf("Error uploading files: %s", err)
+	}
+
+	if !d.Get("delete_remote").(bool) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting %d objects from %s/%s", len(remove), cn, prefix)
+	err = objectStorageObjectSyncV1Run(ctx, remove, parallelism, func(name string) error {
+		return objectStorageObjectSyncV1Delete(client, cn, prefix+name)
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting objects: %s", err)
+	}
+
+	return nil
+}
+
+func resourceObjectStorageObjectSyncV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("include") || !d.NewValueKnown("exclude") {
+		return d.SetNewComputed("files")
+	}
+
+	include := expandToStringSlice(d.Get("include").([]interface{}))
+	exclude := expandToStringSlice(d.Get("exclude").([]interface{}))
+
+	local, err := objectStorageObjectSyncV1LocalFiles(d.Get("source_dir").(string), include, exclude)
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("files", local)
+}
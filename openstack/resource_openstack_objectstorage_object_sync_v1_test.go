@@ -0,0 +1,219 @@
+package openstack
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+func TestAccObjectStorageV1ObjectSync_basic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf_test_objectstorage_object_sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.MkdirAll(filepath.Join(dir, "css"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("foo"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "css", "site.css"), []byte("bar"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "notes.txt"), []byte("foobar"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckObjectStorageV1ObjectSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ObjectSyncBasic(dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_sync_v1.site_1", "files.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_sync_v1.site_1", "files.index.html", fooMD5()),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_sync_v1.site_1", "files.css/site.css", barMD5()),
+					testAccCheckObjectStorageV1ObjectSyncObject("site/index.html", "text/html"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("foobar"), 0600); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectStorageV1ObjectSyncBasic(dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_sync_v1.site_1", "files.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_sync_v1.site_1", "files.index.html", foobarMD5()),
+					testAccCheckObjectStorageV1ObjectSyncObjectDeleted("site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectStorageV1ObjectSync_existingObjects(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf_test_objectstorage_object_sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("foo"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckObjectStorageV1ObjectSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Objects, which weren't uploaded by the resource, must not
+				// be deleted.
+				Config: testAccObjectStorageV1ObjectSyncExistingObjects(dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_sync_v1.site_1", "files.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_sync_v1.site_1", "files.index.html", fooMD5()),
+					testAccCheckObjectStorageV1ObjectSyncObject("site/existing.html", "text/html"),
+				),
+			},
+			{
+				Config:   testAccObjectStorageV1ObjectSyncExistingObjects(dir),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStorageV1ObjectSyncDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_objectstorage_object_sync_v1" {
+			continue
+		}
+
+		_, err := objects.Get(objectStorageClient, rs.Primary.Attributes["container_name"], "site/index.html", nil).Extract()
+		if err == nil {
+			return fmt.Errorf("Synced object still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckObjectStorageV1ObjectSyncObject(name, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		objectStorageClient, err := config.ObjectStorageV1Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+		}
+
+		object, err := objects.Get(objectStorageClient, "tf_test_container_1", name, nil).Extract()
+		if err != nil {
+			return err
+		}
+
+		if object.ContentType != contentType {
+			return fmt.Errorf("Expected content type %s for %s, got %s", contentType, name, object.ContentType)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectStorageV1ObjectSyncObjectDeleted(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		objectStorageClient, err := config.ObjectStorageV1Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+		}
+
+		_, err = objects.Get(objectStorageClient, "tf_test_container_1", name, nil).Extract()
+		if err == nil {
+			return fmt.Errorf("Object %s still exists", name)
+		}
+
+		return nil
+	}
+}
+
+func testAccObjectStorageV1ObjectSyncBasic(dir string) string {
+	return fmt.Sprintf(`
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+}
+
+resource "openstack_objectstorage_object_sync_v1" "site_1" {
+  container_name      = "${openstack_objectstorage_container_v1.container_1.name}"
+  source_dir          = "%s"
+  prefix              = "site/"
+  exclude             = ["*.txt"]
+  detect_content_type = true
+
+  metadata = {
+    test = "true"
+  }
+}
+`, dir)
+}
+
+func testAccObjectStorageV1ObjectSyncExistingObjects(dir string) string {
+	return fmt.Sprintf(`
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+}
+
+resource "openstack_objectstorage_object_v1" "existing_1" {
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  name           = "site/existing.html"
+  content        = "existing"
+  content_type   = "text/html"
+}
+
+resource "openstack_objectstorage_object_sync_v1" "site_1" {
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  source_dir     = "%s"
+  prefix         = "site/"
+
+  depends_on = [openstack_objectstorage_object_v1.existing_1]
+}
+`, dir)
+}
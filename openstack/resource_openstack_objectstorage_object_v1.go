@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/go-homedir"
 
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
@@ -119,7 +120,66 @@ func resourceObjectStorageObjectV1() *schema.Resource {
 				ConflictsWith: []string{"content", "copy_from", "object_manifest"},
 			},
 
+			"segment_size": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"content", "copy_from", "object_manifest"},
+				ValidateFunc: validation.Any(
+					validation.IntInSlice([]int{0}),
+					validation.IntAtLeast(objectStorageObjectV1MinSegmentSize),
+				),
+			},
+
+			"segment_container": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"content", "copy_from", "object_manifest"},
+			},
+
+			"segment_parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
+
+			"large_object_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "slo",
+				ValidateFunc: validation.StringInSlice([]string{
+					"slo", "dlo",
+				}, false),
+			},
+
+			"segments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			// Read Only
+			"static_large_object": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"content_length": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -159,6 +219,8 @@ func resourceObjectStorageObjectV1Create(ctx context.Context, d *schema.Resource
 	}
 
 	var isValid bool
+	var largeObjectSource *os.File
+	var largeObjectSize, segmentSize int64
 	if v, ok := d.GetOk("source"); ok {
 		isValid = true
 		file, size, err := resourceObjectSourceV1(v.(string))
@@ -169,6 +231,9 @@ func resourceObjectStorageObjectV1Create(ctx context.Context, d *schema.Resource
 		createOpts.Content = file
 		createOpts.ContentLength = size
 		defer file.Close()
+
+		segmentSize = objectStorageObjectV1SegmentSize(int64(d.Get("segment_size").(int)), size)
+		largeObjectSource, largeObjectSize = file, size
 	}
 
 	if v, ok := d.GetOk("content"); ok {
@@ -227,6 +292,18 @@ func resourceObjectStorageObjectV1Create(ctx context.Context, d *schema.Resource
 		createOpts.ETag = v.(string)
 	}
 
+	if segmentSize > 0 {
+		err = objectStorageObjectV1UploadLargeObject(ctx, d, objectStorageClient, cn, name, largeObjectSource, largeObjectSize, segmentSize, createOpts)
+		if err != nil {
+			return diag.Errorf("Error creating OpenStack container large object: %s", err)
+		}
+
+		// Store the ID now
+		d.SetId(fmt.Sprintf("%s/%s", cn, name))
+
+		return resourceObjectStorageObjectV1Read(ctx, d, meta)
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	_, err = objects.Create(objectStorageClient, cn, name, createOpts).Extract()
 	if err != nil {
@@ -266,7 +343,12 @@ func resourceObjectStorageObjectV1Read(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[DEBUG] Retrieved OpenStack Object Storage Object: %#v", result)
 
-	d.Set("etag", result.ETag)
+	// The ETag of a large object is derived from its segments. Keep the MD5
+	// checksum of the source, which was computed during the upload instead.
+	if len(d.Get("segments").([]interface{})) == 0 {
+		d.Set("etag", result.ETag)
+	}
+	d.Set("static_large_object", result.StaticLargeObject)
 	d.Set("content_disposition", result.ContentDisposition)
 	d.Set("content_encoding", result.ContentEncoding)
 	d.Set("content_length", result.ContentLength)
@@ -370,12 +452,40 @@ func resourceObjectStorageObjectV1Update(ctx context.Context, d *schema.Resource
 		createOpts.ETag = d.Get("etag").(string)
 	}
 
+	if v := d.Get("source").(string); v != "" {
+		file, size, err := resourceObjectSourceV1(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer file.Close()
+
+		if segmentSize := objectStorageObjectV1SegmentSize(int64(d.Get("segment_size").(int)), size); segmentSize > 0 {
+			// The manifest is replaced, so all metadata has to be sent.
+			createOpts.Metadata = resourceObjectMetadataV1(d)
+			createOpts.NoETag = false
+			err = objectStorageObjectV1UploadLargeObject(ctx, d, objectStorageClient, cn, name, file, size, segmentSize, createOpts)
+			if err != nil {
+				return diag.Errorf("Error updating OpenStack container large object: %s", err)
+			}
+
+			return resourceObjectStorageObjectV1Read(ctx, d, meta)
+		}
+	}
+
 	log.Printf("[DEBUG] Update Options: %#v", createOpts)
 	_, err = objects.Create(objectStorageClient, cn, name, createOpts).Extract()
 	if err != nil {
 		return diag.Errorf("Error updating OpenStack container object: %s", err)
 	}
 
+	// The object isn't a large object anymore.
+	if oldContainer, oldSegments := objectStorageObjectV1StateSegments(d); len(oldSegments) > 0 {
+		if err := objectStorageObjectV1DeleteSegments(objectStorageClient, oldContainer, oldSegments, nil); err != nil {
+			return diag.Errorf("Error deleting segments of OpenStack container object %s: %s", name, err)
+		}
+		d.Set("segments", nil)
+	}
+
 	return resourceObjectStorageObjectV1Read(ctx, d, meta)
 }
 
@@ -390,10 +500,27 @@ func resourceObjectStorageObjectV1Delete(ctx context.Context, d *schema.Resource
 	cn := d.Get("container_name").(string)
 	deleteOpts := &objects.DeleteOpts{}
 
+	segmentContainer, segments := objectStorageObjectV1StateSegments(d)
+	if len(segments) > 0 && d.Get("static_large_object").(bool) {
+		deleteOpts.MultipartManifest = "delete"
+	}
+
 	_, err = objects.Delete(objectStorageClient, cn, name, deleteOpts).Extract()
 	if err != nil {
 		return diag.FromErr(CheckDeleted(d, err, fmt.Sprintf("Error deleting OpenStack container object: %s", name)))
 	}
+
+	if len(segments) > 0 {
+		if err := objectStorageObjectV1DeleteSegments(objectStorageClient, segmentContainer, segments, nil); err != nil {
+			return diag.Errorf("Error deleting segments of OpenStack container object %s: %s", name, err)
+		}
+
+		segmentPrefix := objectStorageObjectV1SegmentPrefix(name, d.Get("large_object_type").(string))
+		if err := objectStorageObjectV1DeleteOrphanedSegments(objectStorageClient, segmentContainer, segmentPrefix, nil); err != nil {
+			return diag.Errorf("Error deleting orphaned segments of OpenStack container object %s: %s", name, err)
+		}
+	}
+
 	return nil
 }
 
@@ -176,6 +176,84 @@ func TestAccObjectStorageV1Object_fromSource(t *testing.T) {
 	})
 }
 
+func TestAccObjectStorageV1Object_largeObject(t *testing.T) {
+	var object objects.GetHeader
+
+	// Swift requires all segments of a static large object, except for the
+	// last one, to be at least 1 MiB in size.
+	segment := strings.Repeat("f", objectStorageObjectV1MinSegmentSize)
+	segmentMD5 := fmt.Sprintf("%x", md5.Sum([]byte(segment)))
+	largeObjectMD5 := fmt.Sprintf("%x", md5.Sum([]byte(segment+"bar")))
+	largeObjectManifestMD5 := fmt.Sprintf("\"%x\"", md5.Sum([]byte(segmentMD5+barMD5())))
+
+	tmpfile, err := ioutil.TempFile("", "tf_test_objectstorage_object")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+	if _, err := tmpfile.Write([]byte(segment + "bar")); err != nil {
+		log.Fatal(err)
+	}
+	if err := tmpfile.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckObjectStorageV1ObjectDestroy(s, "terraform/test/myfile.txt")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccObjectStorageV1ObjectLargeObject, tmpfile.Name(), "slo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageV1ObjectExists(
+						"openstack_objectstorage_object_v1.myfile", &object),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "static_large_object", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "content_length", "1048579"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "etag", largeObjectMD5),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "segments.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "segments.0.etag", segmentMD5),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "segments.1.etag", barMD5()),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "segments.0.path", "terraform/test/myfile.txt/slo/1048579/1048576/00000000"),
+					testAccCheckObjectStorageV1ObjectETag(largeObjectManifestMD5, &object),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := ioutil.WriteFile(tmpfile.Name(), []byte(segment+"foo"), 0600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: fmt.Sprintf(testAccObjectStorageV1ObjectLargeObject, tmpfile.Name(), "dlo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "static_large_object", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "object_manifest",
+						"tf_test_container_1_segments/terraform/test/myfile.txt/dlo/1048579/1048576/"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "segments.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "segments.1.etag", fooMD5()),
+				),
+			},
+		},
+	})
+}
+
 func TestAccObjectStorageV1Object_detectContentType(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -336,6 +414,16 @@ func testAccCheckObjectStorageV1ObjectDeleteAtMatches(expected string, object *o
 	}
 }
 
+func testAccCheckObjectStorageV1ObjectETag(expected string, object *objects.GetHeader) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if object.ETag != expected {
+			return fmt.Errorf("Expected object ETag %s, got %s", expected, object.ETag)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckObjectStorageV1DestroyContainer(container, object string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -504,3 +592,24 @@ resource "openstack_objectstorage_object_v1" "myfile" {
   }
 }
 `
+
+const testAccObjectStorageV1ObjectLargeObject = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+}
+
+resource "openstack_objectstorage_container_v1" "segments_1" {
+  name          = "tf_test_container_1_segments"
+  force_destroy = true
+}
+
+resource "openstack_objectstorage_object_v1" "myfile" {
+  name              = "terraform/test/myfile.txt"
+  container_name    = "${openstack_objectstorage_container_v1.container_1.name}"
+  content_type      = "text/plain"
+  source            = "%s"
+  segment_size      = 1048576
+  segment_container = "${openstack_objectstorage_container_v1.segments_1.name}"
+  large_object_type = "%s"
+}
+`
@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
 )
 
@@ -28,6 +30,8 @@ func resourceOrchestrationStackV1() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: resourceOrchestrationStackV1CustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -75,6 +79,103 @@ func resourceOrchestrationStackV1() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"adopt_stack_data": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"abandon_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"preview_updates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"check_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"update_preview": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"added": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"deleted": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"replaced": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"unchanged": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"updated": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"check_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"check_status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"drifted_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			// Below are schemas for stack read
 			"capabilities": {
 				Type:     schema.TypeList,
@@ -202,16 +303,35 @@ func resourceOrchestrationStackV1Create(ctx context.Context, d *schema.ResourceD
 		createOpts.Timeout = d.Get("timeout").(int)
 	}
 
-	log.Printf("[DEBUG] Creating openstack_orchestration_stack_v1")
-	stack, err := stacks.Create(orchestrationClient, createOpts).Extract()
-	if err != nil {
-		log.Printf("[DEBUG] openstack_orchestration_stack_v1 error occurred during Create: %s", err)
-		return diag.Errorf("Error creating openstack_orchestration_stack_v1: %s", err)
+	var stack *stacks.CreatedStack
+	if v, ok := d.GetOk("adopt_stack_data"); ok {
+		adoptOpts := &stacks.AdoptOpts{
+			AdoptStackData:  v.(string),
+			Name:            createOpts.Name,
+			TemplateOpts:    createOpts.TemplateOpts,
+			Timeout:         createOpts.Timeout,
+			DisableRollback: createOpts.DisableRollback,
+			EnvironmentOpts: createOpts.EnvironmentOpts,
+			Parameters:      createOpts.Parameters,
+		}
+
+		log.Printf("[DEBUG] Adopting openstack_orchestration_stack_v1")
+		stack, err = stacks.Adopt(orchestrationClient, adoptOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error adopting openstack_orchestration_stack_v1: %s", err)
+		}
+	} else {
+		log.Printf("[DEBUG] Creating openstack_orchestration_stack_v1")
+		stack, err = stacks.Create(orchestrationClient, createOpts).Extract()
+		if err != nil {
+			log.Printf("[DEBUG] openstack_orchestration_stack_v1 error occurred during Create: %s", err)
+			return diag.Errorf("Error creating openstack_orchestration_stack_v1: %s", err)
+		}
 	}
 
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"CREATE_IN_PROGRESS", "INIT_COMPLETE"},
-		Target:     []string{"CREATE_COMPLETE", "UPDATE_COMPLETE", "UPDATE_IN_PROGRESS"},
+		Pending:    []string{"CREATE_IN_PROGRESS", "ADOPT_IN_PROGRESS", "INIT_COMPLETE"},
+		Target:     []string{"CREATE_COMPLETE", "ADOPT_COMPLETE", "UPDATE_COMPLETE", "UPDATE_IN_PROGRESS"},
 		Refresh:    orchestrationStackV1StateRefreshFunc(orchestrationClient, stack.ID, false),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      5 * time.Second,
@@ -228,6 +348,12 @@ func resourceOrchestrationStackV1Create(ctx context.Context, d *schema.ResourceD
 	d.SetId(stack.ID)
 	log.Printf("[INFO] openstack_orchestration_stack_v1 %s create complete", stack.ID)
 
+	if d.Get("check_trigger").(string) != "" {
+		if err := resourceOrchestrationStackV1Check(ctx, d, orchestrationClient, d.Get("name").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceOrchestrationStackV1Read(ctx, d, meta)
 }
 
@@ -255,16 +381,7 @@ func resourceOrchestrationStackV1Read(ctx context.Context, d *schema.ResourceDat
 	d.Set("timeout", stack.Timeout)
 
 	// Set the outputs
-	outputs := make([]map[string]interface{}, 0, len(stack.Outputs))
-	for _, o := range stack.Outputs {
-		output := make(map[string]interface{})
-		output["description"] = o["description"]
-		output["output_key"] = o["output_key"]
-		output["output_value"] = o["output_value"]
-
-		outputs = append(outputs, output)
-	}
-	d.Set("outputs", outputs)
+	d.Set("outputs", flattenOrchestrationStackV1Outputs(stack.Outputs))
 
 	params := stack.Parameters
 	if stack.Parameters != nil {
@@ -295,6 +412,19 @@ func resourceOrchestrationStackV1Read(ctx context.Context, d *schema.ResourceDat
 		log.Printf("[DEBUG] Unable to set openstack_orchestration_stack_v1 updated_at: %s", err)
 	}
 
+	// The results of the last stack check are only available until the
+	// next stack action, so they are kept in the state until then.
+	if stack.Status == "CHECK_COMPLETE" || stack.Status == "CHECK_FAILED" {
+		drifted, err := orchestrationStackV1DriftedResources(orchestrationClient, stack.Name, d.Id())
+		if err != nil {
+			return diag.Errorf("Error retrieving openstack_orchestration_stack_v1 %s resources: %s", d.Id(), err)
+		}
+
+		d.Set("check_status", stack.Status)
+		d.Set("check_status_reason", stack.StatusReason)
+		d.Set("drifted_resources", drifted)
+	}
+
 	log.Printf("[DEBUG] openstack_orchestration_stack_v1 information fetched: %s", d.Id())
 	return nil
 }
@@ -308,62 +438,78 @@ func resourceOrchestrationStackV1Update(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("Error creating OpenStack Orchestration client: %s", err)
 	}
 
-	templateOpts, err := buildTemplateOpts(d)
-	if err != nil {
-		return diag.Errorf("Error building openstack_orchestration_stack_v1 template options: %s", err)
-	}
-	updateOpts := &stacks.UpdateOpts{
-		TemplateOpts: templateOpts,
-	}
-	env, err := buildEnvironmentOpts(d)
-	if err != nil {
-		return diag.Errorf("Error building openstack_orchestration_stack_v1 environment options: %s", err)
-	}
-	if env != nil {
-		updateOpts.EnvironmentOpts = env
-	}
-	if d.Get("parameters") != nil {
-		updateOpts.Parameters = d.Get("parameters").(map[string]interface{})
-	}
-	if d.Get("timeout") != nil {
-		updateOpts.Timeout = d.Get("timeout").(int)
-	}
-	if d.Get("tags") != nil {
-		t := d.Get("tags").([]interface{})
-		tags := make([]string, len(t))
-		for _, tag := range t {
-			tags = append(tags, tag.(string))
-		}
-		updateOpts.Tags = tags
-	}
-
 	stack, err := stacks.Find(orchestrationClient, d.Id()).Extract()
 	if err != nil {
 		return diag.Errorf("Error retrieving openstack_orchestration_stack_v1 %s before Update:  %s", d.Id(), err)
 	}
 
-	log.Printf("[DEBUG] Updating openstack_orchestration_stack_v1")
-	result := stacks.Update(orchestrationClient, stack.Name, d.Id(), updateOpts)
-	if result.Err != nil {
-		return diag.Errorf("Error updating openstack_orchestration_stack_v1 %s: %s", d.Id(), result.Err)
-	}
+	// abandon_on_destroy, preview_updates and check_trigger are only used by
+	// the provider, so changing them must not update the stack in Heat.
+	var diags diag.Diagnostics
+	if d.HasChanges("template_opts", "environment_opts", "parameters", "timeout", "tags", "disable_rollback") {
+		templateOpts, err := buildTemplateOpts(d)
+		if err != nil {
+			return diag.Errorf("Error building openstack_orchestration_stack_v1 template options: %s", err)
+		}
+		updateOpts := &stacks.UpdateOpts{
+			TemplateOpts: templateOpts,
+		}
+		env, err := buildEnvironmentOpts(d)
+		if err != nil {
+			return diag.Errorf("Error building openstack_orchestration_stack_v1 environment options: %s", err)
+		}
+		if env != nil {
+			updateOpts.EnvironmentOpts = env
+		}
+		if d.Get("parameters") != nil {
+			updateOpts.Parameters = d.Get("parameters").(map[string]interface{})
+		}
+		if d.Get("timeout") != nil {
+			updateOpts.Timeout = d.Get("timeout").(int)
+		}
+		if d.Get("tags") != nil {
+			t := d.Get("tags").([]interface{})
+			tags := make([]string, len(t))
+			for _, tag := range t {
+				tags = append(tags, tag.(string))
+			}
+			updateOpts.Tags = tags
+		}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"UPDATE_IN_PROGRESS"},
-		Target:     []string{"UPDATE_COMPLETE"},
-		Refresh:    orchestrationStackV1StateRefreshFunc(orchestrationClient, d.Id(), true),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
+		log.Printf("[DEBUG] Updating openstack_orchestration_stack_v1")
+		result := stacks.Update(orchestrationClient, stack.Name, d.Id(), updateOpts)
+		if result.Err != nil {
+			return diag.Errorf("Error updating openstack_orchestration_stack_v1 %s: %s", d.Id(), result.Err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"UPDATE_IN_PROGRESS"},
+			Target:     []string{"UPDATE_COMPLETE"},
+			Refresh:    orchestrationStackV1StateRefreshFunc(orchestrationClient, d.Id(), true),
+			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("Error waiting for openstack_orchestration_stack_v1 %s to Update:  %s", d.Id(), err)
+		}
+
+		log.Printf("[INFO] openstack_orchestration_stack_v1 %s update complete", d.Id())
+
+		if d.Get("preview_updates").(bool) {
+			diags = orchestrationStackV1UpdatePreviewDiagnostics(stack.Name, d.Get("update_preview").([]interface{}))
+		}
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return diag.Errorf("Error waiting for openstack_orchestration_stack_v1 %s to Update:  %s", d.Id(), err)
+	if d.HasChange("check_trigger") && d.Get("check_trigger").(string) != "" {
+		if err := resourceOrchestrationStackV1Check(ctx, d, orchestrationClient, stack.Name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
 	}
 
-	log.Printf("[INFO] openstack_orchestration_stack_v1 %s update complete", d.Id())
-	return resourceOrchestrationStackV1Read(ctx, d, meta)
+	return append(diags, resourceOrchestrationStackV1Read(ctx, d, meta)...)
 }
 
 func resourceOrchestrationStackV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -379,7 +525,12 @@ func resourceOrchestrationStackV1Delete(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_orchestration_stack_v1"))
 	}
 
-	if stack.Status != "DELETE_IN_PROGRESS" {
+	if d.Get("abandon_on_destroy").(bool) {
+		log.Printf("[DEBUG] Abandoning openstack_orchestration_stack_v1: %s", d.Id())
+		if err := stacks.Abandon(orchestrationClient, stack.Name, d.Id()).Err; err != nil {
+			return diag.FromErr(CheckDeleted(d, err, "Error abandoning openstack_orchestration_stack_v1"))
+		}
+	} else if stack.Status != "DELETE_IN_PROGRESS" {
 		log.Printf("[DEBUG] Deleting openstack_orchestration_stack_v1: %s", d.Id())
 		if err := stacks.Delete(orchestrationClient, stack.Name, d.Id()).ExtractErr(); err != nil {
 			return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_orchestration_stack_v1"))
@@ -403,3 +554,82 @@ func resourceOrchestrationStackV1Delete(ctx context.Context, d *schema.ResourceD
 	log.Printf("[INFO] openstack_orchestration_stack_v1 %s delete complete", d.Id())
 	return nil
 }
+
+// resourceOrchestrationStackV1Check runs a Heat stack check and waits for it
+// to finish. Its results are read back by resourceOrchestrationStackV1Read.
+func resourceOrchestrationStackV1Check(ctx context.Context, d *schema.ResourceData, client *gophercloud.ServiceClient, stackName string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Checking openstack_orchestration_stack_v1 %s", d.Id())
+	if err := orchestrationStackV1Check(client, stackName, d.Id()); err != nil {
+		return fmt.Errorf("Error checking openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CHECK_IN_PROGRESS"},
+		Target:     []string{"CHECK_COMPLETE", "CHECK_FAILED"},
+		Refresh:    orchestrationStackV1CheckStateRefreshFunc(client, d.Id()),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_orchestration_stack_v1 %s check to complete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceOrchestrationStackV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Previews are only possible for existing stacks.
+	if d.Id() == "" || !d.Get("preview_updates").(bool) {
+		return nil
+	}
+
+	if !d.HasChanges("template_opts", "environment_opts", "parameters") {
+		return nil
+	}
+
+	if !d.NewValueKnown("template_opts") || !d.NewValueKnown("environment_opts") || !d.NewValueKnown("parameters") {
+		return d.SetNewComputed("update_preview")
+	}
+
+	config := meta.(*Config)
+	region := config.Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	orchestrationClient, err := config.OrchestrationV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Orchestration client: %s", err)
+	}
+
+	templateOpts, err := buildTemplateOpts(d)
+	if err != nil {
+		return fmt.Errorf("Error building openstack_orchestration_stack_v1 template options: %s", err)
+	}
+	updateOpts := stacks.UpdateOpts{
+		TemplateOpts: templateOpts,
+	}
+	env, err := buildEnvironmentOpts(d)
+	if err != nil {
+		return fmt.Errorf("Error building openstack_orchestration_stack_v1 environment options: %s", err)
+	}
+	if env != nil {
+		updateOpts.EnvironmentOpts = env
+	}
+	if v, ok := d.GetOk("parameters"); ok {
+		updateOpts.Parameters = v.(map[string]interface{})
+	}
+
+	stackName, _ := d.GetChange("name")
+	preview, err := orchestrationStackV1PreviewUpdate(orchestrationClient, stackName.(string), d.Id(), updateOpts)
+	if err != nil {
+		return fmt.Errorf("Error previewing openstack_orchestration_stack_v1 %s update: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] openstack_orchestration_stack_v1 %s update preview: %#v", d.Id(), preview)
+
+	return d.SetNew("update_preview", flattenOrchestrationStackV1UpdatePreview(preview))
+}
@@ -136,6 +136,107 @@ func TestAccOrchestrationV1Stack_outputs(t *testing.T) {
 	})
 }
 
+func TestAccOrchestrationV1Stack_previewUpdates(t *testing.T) {
+	var stack stacks.RetrievedStack
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckOrchestrationV1StackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrchestrationV1StackPreviewUpdates(4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackExists("openstack_orchestration_stack_v1.stack_6", &stack),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_6", "update_preview.#", "0"),
+				),
+			},
+			{
+				Config: testAccOrchestrationV1StackPreviewUpdates(5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackExists("openstack_orchestration_stack_v1.stack_6", &stack),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_6", "parameters.length", "5"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_6", "update_preview.#", "1"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_6", "update_preview.0.replaced.#", "1"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_6", "update_preview.0.replaced.0", "random"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_6", "update_preview.0.unchanged.0", "test_res"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrchestrationV1Stack_checkStack(t *testing.T) {
+	var stack stacks.RetrievedStack
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckOrchestrationV1StackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrchestrationV1StackCheckStack(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackExists("openstack_orchestration_stack_v1.stack_7", &stack),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_7", "status", "CREATE_COMPLETE"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_7", "check_status", ""),
+				),
+			},
+			{
+				// Refreshing must not run a stack check.
+				Config:   testAccOrchestrationV1StackCheckStack(""),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccOrchestrationV1StackCheckStack("1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackExists("openstack_orchestration_stack_v1.stack_7", &stack),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_7", "check_status", "CHECK_COMPLETE"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_7", "drifted_resources.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrchestrationV1Stack_abandonOnDestroy(t *testing.T) {
+	var stack stacks.RetrievedStack
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckOrchestrationV1StackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrchestrationV1StackAbandonOnDestroy(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackExists("openstack_orchestration_stack_v1.stack_8", &stack),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_8", "abandon_on_destroy", "true"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_8", "status", "CREATE_COMPLETE"),
+				),
+			},
+			{
+				// Toggling a provider-side flag must not update the stack.
+				Config: testAccOrchestrationV1StackAbandonOnDestroy(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackExists("openstack_orchestration_stack_v1.stack_8", &stack),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_8", "abandon_on_destroy", "false"),
+					resource.TestCheckResourceAttr("openstack_orchestration_stack_v1.stack_8", "status", "CREATE_COMPLETE"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOrchestrationV1StackDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	orchestrationClient, err := config.OrchestrationV1Client(osRegionName)
@@ -296,3 +397,60 @@ resource "openstack_orchestration_stack_v1" "stack_5" {
   disable_rollback = true
 }
 `
+
+func testAccOrchestrationV1StackPreviewUpdates(length int) string {
+	return fmt.Sprintf(`
+resource "openstack_orchestration_stack_v1" "stack_6" {
+  name = "stack_6"
+  parameters = {
+	length = %d
+  }
+  template_opts = {
+	Bin = "heat_template_version: 2013-05-23\nparameters:\n  length:\n    type: number\nresources:\n  test_res:\n    type: OS::Heat::TestResource\n  random:\n    type: OS::Heat::RandomString\n    properties:\n      length: {get_param: length}\n"
+  }
+  environment_opts = {
+	Bin = "\n"
+  }
+  disable_rollback = true
+  preview_updates  = true
+}
+`, length)
+}
+
+func testAccOrchestrationV1StackCheckStack(checkTrigger string) string {
+	return fmt.Sprintf(`
+resource "openstack_orchestration_stack_v1" "stack_7" {
+  name = "stack_7"
+  parameters = {
+	length = 4
+  }
+  template_opts = {
+	Bin = "heat_template_version: 2013-05-23\nparameters:\n  length:\n    type: number\nresources:\n  test_res:\n    type: OS::Heat::TestResource\n  random:\n    type: OS::Heat::RandomString\n    properties:\n      length: {get_param: length}\n"
+  }
+  environment_opts = {
+	Bin = "\n"
+  }
+  disable_rollback = true
+  check_trigger    = "%s"
+}
+`, checkTrigger)
+}
+
+func testAccOrchestrationV1StackAbandonOnDestroy(abandonOnDestroy bool) string {
+	return fmt.Sprintf(`
+resource "openstack_orchestration_stack_v1" "stack_8" {
+  name = "stack_8"
+  parameters = {
+	length = 4
+  }
+  template_opts = {
+	Bin = "heat_template_version: 2013-05-23\nparameters:\n  length:\n    type: number\nresources:\n  test_res:\n    type: OS::Heat::TestResource\n  random:\n    type: OS::Heat::RandomString\n    properties:\n      length: {get_param: length}\n"
+  }
+  environment_opts = {
+	Bin = "\n"
+  }
+  disable_rollback   = true
+  abandon_on_destroy = %t
+}
+`, abandonOnDestroy)
+}
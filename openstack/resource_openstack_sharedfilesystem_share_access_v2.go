@@ -22,6 +22,7 @@ func resourceSharedFilesystemShareAccessV2() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceSharedFilesystemShareAccessV2Create,
 		ReadContext:   resourceSharedFilesystemShareAccessV2Read,
+		UpdateContext: resourceSharedFilesystemShareAccessV2Update,
 		DeleteContext: resourceSharedFilesystemShareAccessV2Delete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceSharedFilesystemShareAccessV2Import,
@@ -81,6 +82,30 @@ func resourceSharedFilesystemShareAccessV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"lock_visibility": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"lock_deletion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"lock_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 		},
 	}
 }
@@ -100,10 +125,25 @@ func resourceSharedFilesystemShareAccessV2Create(ctx context.Context, d *schema.
 
 	shareID := d.Get("share_id").(string)
 
-	grantOpts := shares.GrantAccessOpts{
-		AccessType:  accessType,
-		AccessTo:    d.Get("access_to").(string),
-		AccessLevel: d.Get("access_level").(string),
+	grantOpts := SharedFilesystemShareAccessV2GrantOpts{
+		GrantAccessOpts: shares.GrantAccessOpts{
+			AccessType:  accessType,
+			AccessTo:    d.Get("access_to").(string),
+			AccessLevel: d.Get("access_level").(string),
+		},
+		Metadata:       expandToMapStringString(d.Get("metadata").(map[string]interface{})),
+		LockVisibility: d.Get("lock_visibility").(bool),
+		LockDeletion:   d.Get("lock_deletion").(bool),
+		LockReason:     d.Get("lock_reason").(string),
+	}
+
+	// Access rule metadata is only available since microversion 2.45 and
+	// resource locks since microversion 2.82.
+	if len(grantOpts.Metadata) > 0 {
+		sfsClient.Microversion = sharedFilesystemV2ShareAccessRulesMicroversion
+	}
+	if grantOpts.LockVisibility || grantOpts.LockDeletion {
+		sfsClient.Microversion = sharedFilesystemV2ShareAccessLockMicroversion
 	}
 
 	log.Printf("[DEBUG] openstack_sharedfilesystem_share_access_v2 create options: %#v", grantOpts)
@@ -173,6 +213,12 @@ func resourceSharedFilesystemShareAccessV2Read(ctx context.Context, d *schema.Re
 		d.Set("access_key", access.AccessKey)
 		d.Set("state", access.State)
 
+		metadata := make(map[string]string, len(access.Metadata))
+		for k, v := range access.Metadata {
+			metadata[k] = fmt.Sprint(v)
+		}
+		d.Set("metadata", metadata)
+
 		return nil
 	case shares.AccessRight:
 		d.Set("access_type", access.AccessType)
@@ -191,6 +237,41 @@ func resourceSharedFilesystemShareAccessV2Read(ctx context.Context, d *schema.Re
 	return diag.Errorf("Unknown share access rules type: %T", access)
 }
 
+func resourceSharedFilesystemShareAccessV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	// Access rule metadata is only available since microversion 2.45.
+	sfsClient.Microversion = sharedFilesystemV2ShareAccessRulesMicroversion
+
+	if d.HasChange("metadata") {
+		o, n := d.GetChange("metadata")
+		oldMetadata := o.(map[string]interface{})
+		newMetadata := n.(map[string]interface{})
+
+		for oldKey := range oldMetadata {
+			if _, ok := newMetadata[oldKey]; ok {
+				continue
+			}
+
+			if err := sharedFilesystemShareAccessV2DeleteMetadatum(sfsClient, d.Id(), oldKey); err != nil {
+				return diag.Errorf("Error deleting openstack_sharedfilesystem_share_access_v2 %s metadata %s: %s", d.Id(), oldKey, err)
+			}
+		}
+
+		if len(newMetadata) > 0 {
+			if err := sharedFilesystemShareAccessV2SetMetadata(sfsClient, d.Id(), expandToMapStringString(newMetadata)); err != nil {
+				return diag.Errorf("Error updating openstack_sharedfilesystem_share_access_v2 %s metadata: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceSharedFilesystemShareAccessV2Read(ctx, d, meta)
+}
+
 func resourceSharedFilesystemShareAccessV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
@@ -202,7 +283,15 @@ func resourceSharedFilesystemShareAccessV2Delete(ctx context.Context, d *schema.
 
 	shareID := d.Get("share_id").(string)
 
-	revokeOpts := shares.RevokeAccessOpts{AccessID: d.Id()}
+	revokeOpts := SharedFilesystemShareAccessV2RevokeOpts{
+		RevokeAccessOpts: shares.RevokeAccessOpts{AccessID: d.Id()},
+	}
+
+	// Remove the deletion lock together with the access rule.
+	if d.Get("lock_deletion").(bool) {
+		sfsClient.Microversion = sharedFilesystemV2ShareAccessLockMicroversion
+		revokeOpts.Unrestrict = true
+	}
 
 	timeout := d.Timeout(schema.TimeoutDelete)
 
@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSFSV2ShareGroupSnapshot_basic(t *testing.T) {
+	var snapshot sharedFilesystemShareGroupSnapshotV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareGroupSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareGroupSnapshotConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareGroupSnapshotExists("openstack_sharedfilesystem_share_group_snapshot_v2.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_snapshot_v2.snapshot_1", "name", "snapshot_1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_snapshot_v2.snapshot_1", "description", "test share group snapshot"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_snapshot_v2.snapshot_1", "status", "available"),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_share_group_snapshot_v2.snapshot_1", "share_group_id",
+						"openstack_sharedfilesystem_share_group_v2.group_1", "id"),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareGroupSnapshotConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareGroupSnapshotExists("openstack_sharedfilesystem_share_group_snapshot_v2.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_snapshot_v2.snapshot_1", "name", "snapshot_1_updated"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_snapshot_v2.snapshot_1", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareGroupSnapshotDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_share_group_snapshot_v2" {
+			continue
+		}
+
+		_, err := sharedFilesystemShareGroupSnapshotV2Get(sfsClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Manila share group snapshot still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSV2ShareGroupSnapshotExists(n string, snapshot *sharedFilesystemShareGroupSnapshotV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+		found, err := sharedFilesystemShareGroupSnapshotV2Get(sfsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Share group snapshot not found")
+		}
+
+		*snapshot = *found
+
+		return nil
+	}
+}
+
+var testAccSFSV2ShareGroupSnapshotConfigBasic = fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_share_group_snapshot_v2" "snapshot_1" {
+  share_group_id = openstack_sharedfilesystem_share_group_v2.group_1.id
+  name           = "snapshot_1"
+  description    = "test share group snapshot"
+
+  depends_on = [openstack_sharedfilesystem_share_v2.share_1]
+}
+`, testAccSFSV2ShareGroupConfigBasic)
+
+var testAccSFSV2ShareGroupSnapshotConfigUpdate = fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_share_group_snapshot_v2" "snapshot_1" {
+  share_group_id = openstack_sharedfilesystem_share_group_v2.group_1.id
+  name           = "snapshot_1_updated"
+
+  depends_on = [openstack_sharedfilesystem_share_v2.share_1]
+}
+`, testAccSFSV2ShareGroupConfigBasic)
@@ -0,0 +1,162 @@
+package openstack
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSharedFilesystemShareGroupTypeV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareGroupTypeV2Create,
+		ReadContext:   resourceSharedFilesystemShareGroupTypeV2Read,
+		UpdateContext: resourceSharedFilesystemShareGroupTypeV2Update,
+		DeleteContext: resourceSharedFilesystemShareGroupTypeV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_types": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"is_public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"group_specs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareGroupTypeV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	createOpts := sharedFilesystemShareGroupTypeV2CreateOpts{
+		Name:       d.Get("name").(string),
+		ShareTypes: expandToStringSlice(d.Get("share_types").([]interface{})),
+		IsPublic:   d.Get("is_public").(bool),
+		GroupSpecs: expandToMapStringString(d.Get("group_specs").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_group_type_v2 create options: %#v", createOpts)
+	groupType, err := sharedFilesystemShareGroupTypeV2Create(sfsClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_sharedfilesystem_share_group_type_v2: %s", err)
+	}
+
+	d.SetId(groupType.ID)
+
+	return resourceSharedFilesystemShareGroupTypeV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareGroupTypeV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	groupType, err := sharedFilesystemShareGroupTypeV2Get(sfsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_group_type_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_group_type_v2 %s: %#v", d.Id(), groupType)
+
+	d.Set("name", groupType.Name)
+	d.Set("share_types", groupType.ShareTypes)
+	d.Set("is_public", groupType.IsPublic)
+	d.Set("group_specs", groupType.GroupSpecs)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareGroupTypeV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	if d.HasChange("group_specs") {
+		oldGS, newGS := d.GetChange("group_specs")
+		newGSRaw := newGS.(map[string]interface{})
+
+		// Unset the removed group specs.
+		for oldKey := range oldGS.(map[string]interface{}) {
+			if _, ok := newGSRaw[oldKey]; ok {
+				continue
+			}
+
+			if err := sharedFilesystemShareGroupTypeV2UnsetGroupSpec(sfsClient, d.Id(), oldKey); err != nil {
+				return diag.Errorf("Error unsetting group_spec %s from openstack_sharedfilesystem_share_group_type_v2 %s: %s", oldKey, d.Id(), err)
+			}
+		}
+
+		// Set the new and the changed group specs.
+		if len(newGSRaw) > 0 {
+			if err := sharedFilesystemShareGroupTypeV2SetGroupSpecs(sfsClient, d.Id(), expandToMapStringString(newGSRaw)); err != nil {
+				return diag.Errorf("Error setting group_specs for openstack_sharedfilesystem_share_group_type_v2 %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceSharedFilesystemShareGroupTypeV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareGroupTypeV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	if err := sharedFilesystemShareGroupTypeV2Delete(sfsClient, d.Id()); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_share_group_type_v2"))
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSFSV2ShareGroupType_basic(t *testing.T) {
+	var groupType sharedFilesystemShareGroupTypeV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareGroupTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareGroupTypeConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareGroupTypeExists("openstack_sharedfilesystem_share_group_type_v2.group_type_1", &groupType),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_type_v2.group_type_1", "name", "group_type_1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_type_v2.group_type_1", "is_public", "true"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_type_v2.group_type_1", "share_types.#", "1"),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_share_group_type_v2.group_type_1", "share_types.0",
+						"openstack_sharedfilesystem_share_type_v2.share_type_1", "id"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_type_v2.group_type_1", "group_specs.%", "1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_type_v2.group_type_1", "group_specs.consistent_snapshot_support", "pool"),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareGroupTypeConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareGroupTypeExists("openstack_sharedfilesystem_share_group_type_v2.group_type_1", &groupType),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_type_v2.group_type_1", "group_specs.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareGroupTypeDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_share_group_type_v2" {
+			continue
+		}
+
+		_, err := sharedFilesystemShareGroupTypeV2Get(sfsClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Manila share group type still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSV2ShareGroupTypeExists(n string, groupType *sharedFilesystemShareGroupTypeV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+		found, err := sharedFilesystemShareGroupTypeV2Get(sfsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Share group type not found")
+		}
+
+		*groupType = *found
+
+		return nil
+	}
+}
+
+const testAccSFSV2ShareGroupTypeConfigBasic = `
+resource "openstack_sharedfilesystem_share_type_v2" "share_type_1" {
+  name                         = "share_type_1"
+  driver_handles_share_servers = false
+}
+
+resource "openstack_sharedfilesystem_share_group_type_v2" "group_type_1" {
+  name        = "group_type_1"
+  share_types = [openstack_sharedfilesystem_share_type_v2.share_type_1.id]
+
+  group_specs = {
+    consistent_snapshot_support = "pool"
+  }
+}
+`
+
+const testAccSFSV2ShareGroupTypeConfigUpdate = `
+resource "openstack_sharedfilesystem_share_type_v2" "share_type_1" {
+  name                         = "share_type_1"
+  driver_handles_share_servers = false
+}
+
+resource "openstack_sharedfilesystem_share_group_type_v2" "group_type_1" {
+  name        = "group_type_1"
+  share_types = [openstack_sharedfilesystem_share_type_v2.share_type_1.id]
+  group_specs = {}
+}
+`
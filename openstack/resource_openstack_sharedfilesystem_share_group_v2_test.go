@@ -0,0 +1,156 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSFSV2ShareGroup_basic(t *testing.T) {
+	var shareGroup sharedFilesystemShareGroupV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareGroupConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareGroupExists("openstack_sharedfilesystem_share_group_v2.group_1", &shareGroup),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.group_1", "name", "group_1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.group_1", "description", "test share group"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.group_1", "status", "available"),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_share_group_v2.group_1", "share_group_type_id",
+						"openstack_sharedfilesystem_share_group_type_v2.group_type_1", "id"),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_share_v2.share_1", "share_group_id",
+						"openstack_sharedfilesystem_share_group_v2.group_1", "id"),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareGroupConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareGroupExists("openstack_sharedfilesystem_share_group_v2.group_1", &shareGroup),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.group_1", "name", "group_1_updated"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_group_v2.group_1", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_share_group_v2" {
+			continue
+		}
+
+		_, err := sharedFilesystemShareGroupV2Get(sfsClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Manila share group still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSV2ShareGroupExists(n string, shareGroup *sharedFilesystemShareGroupV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+
+		found, err := sharedFilesystemShareGroupV2Get(sfsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Share group not found")
+		}
+
+		*shareGroup = *found
+
+		return nil
+	}
+}
+
+const testAccSFSV2ShareGroupConfigBase = `
+resource "openstack_sharedfilesystem_share_type_v2" "share_type_1" {
+  name                         = "share_type_1"
+  driver_handles_share_servers = false
+
+  extra_specs = {
+    snapshot_support = "True"
+  }
+}
+
+resource "openstack_sharedfilesystem_share_group_type_v2" "group_type_1" {
+  name        = "group_type_1"
+  share_types = [openstack_sharedfilesystem_share_type_v2.share_type_1.id]
+}
+`
+
+var testAccSFSV2ShareGroupConfigBasic = fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_share_group_v2" "group_1" {
+  name                = "group_1"
+  description         = "test share group"
+  share_group_type_id = openstack_sharedfilesystem_share_group_type_v2.group_type_1.id
+  share_types         = [openstack_sharedfilesystem_share_type_v2.share_type_1.id]
+}
+
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name           = "nfs_share"
+  share_proto    = "NFS"
+  share_type     = openstack_sharedfilesystem_share_type_v2.share_type_1.name
+  size           = 1
+  share_group_id = openstack_sharedfilesystem_share_group_v2.group_1.id
+}
+`, testAccSFSV2ShareGroupConfigBase)
+
+var testAccSFSV2ShareGroupConfigUpdate = fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_share_group_v2" "group_1" {
+  name                = "group_1_updated"
+  share_group_type_id = openstack_sharedfilesystem_share_group_type_v2.group_type_1.id
+  share_types         = [openstack_sharedfilesystem_share_type_v2.share_type_1.id]
+}
+
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name           = "nfs_share"
+  share_proto    = "NFS"
+  share_type     = openstack_sharedfilesystem_share_type_v2.share_type_1.name
+  size           = 1
+  share_group_id = openstack_sharedfilesystem_share_group_v2.group_1.id
+}
+`, testAccSFSV2ShareGroupConfigBase)
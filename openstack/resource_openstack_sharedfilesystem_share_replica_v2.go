@@ -0,0 +1,273 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/errors"
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/replicas"
+)
+
+func resourceSharedFilesystemShareReplicaV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareReplicaV2Create,
+		ReadContext:   resourceSharedFilesystemShareReplicaV2Read,
+		UpdateContext: resourceSharedFilesystemShareReplicaV2Update,
+		DeleteContext: resourceSharedFilesystemShareReplicaV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"share_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"replica_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_server_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareReplicaV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	createOpts := replicas.CreateOpts{
+		ShareID:          d.Get("share_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		ShareNetworkID:   d.Get("share_network_id").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_replica_v2 create options: %#v", createOpts)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	var replica *replicas.Replica
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		replica, err = replicas.Create(sfsClient, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		detailedErr := errors.ErrorDetails{}
+		e := errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return diag.Errorf("Error creating openstack_sharedfilesystem_share_replica_v2: %s: %s", err, e)
+		}
+		for k, msg := range detailedErr {
+			return diag.Errorf("Error creating openstack_sharedfilesystem_share_replica_v2: %s (%d): %s", k, msg.Code, msg.Message)
+		}
+	}
+
+	d.SetId(replica.ID)
+
+	// Wait for the replica to become available and to catch up with the
+	// active replica before continuing.
+	err = waitForSFV2ShareReplica(ctx, sfsClient, replica.ID, sharedFilesystemShareReplicaV2StatusRefreshFunc(sfsClient, replica.ID), "available", []string{"creating"}, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = waitForSFV2ShareReplica(ctx, sfsClient, replica.ID, sharedFilesystemShareReplicaV2StateRefreshFunc(sfsClient, replica.ID), "in_sync", []string{"", "out_of_sync"}, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("active").(bool) {
+		if err := sharedFilesystemShareReplicaV2Promote(ctx, sfsClient, replica.ID, timeout); err != nil {
+			return diag.Errorf("Error promoting openstack_sharedfilesystem_share_replica_v2 %s: %s", replica.ID, err)
+		}
+	}
+
+	return resourceSharedFilesystemShareReplicaV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareReplicaV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	replica, err := replicas.Get(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_replica_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_replica_v2 %s: %#v", d.Id(), replica)
+
+	d.Set("share_id", replica.ShareID)
+	d.Set("availability_zone", replica.AvailabilityZone)
+	d.Set("share_network_id", replica.ShareNetworkID)
+	d.Set("active", replica.State == "active")
+	d.Set("replica_state", replica.State)
+	d.Set("status", replica.Status)
+	d.Set("host", replica.Host)
+	d.Set("share_server_id", replica.ShareServerID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareReplicaV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	if d.HasChange("active") {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+
+		// A replica can't be demoted directly. It becomes a non-active
+		// replica when another replica of the share is promoted, so wait
+		// for that to happen.
+		if !d.Get("active").(bool) {
+			if err := sharedFilesystemShareReplicaV2WaitForDemotion(ctx, sfsClient, d.Id(), d.Get("share_id").(string), timeout); err != nil {
+				return diag.Errorf("Error updating openstack_sharedfilesystem_share_replica_v2 %s: %s", d.Id(), err)
+			}
+		} else if err := sharedFilesystemShareReplicaV2Promote(ctx, sfsClient, d.Id(), timeout); err != nil {
+			return diag.Errorf("Error promoting openstack_sharedfilesystem_share_replica_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSharedFilesystemShareReplicaV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareReplicaV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	replica, err := replicas.Get(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_replica_v2"))
+	}
+
+	// Manila doesn't allow to delete the active replica of a share, so the
+	// active role is handed over to another in_sync replica first.
+	if replica.State == "active" {
+		newActiveID, err := sharedFilesystemShareReplicaV2FindInSync(sfsClient, replica.ShareID, replica.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if newActiveID == "" {
+			return diag.Errorf("Unable to delete openstack_sharedfilesystem_share_replica_v2 %s: it is the active replica of share %s and no other replica is in_sync",
+				d.Id(), replica.ShareID)
+		}
+
+		if err := sharedFilesystemShareReplicaV2Promote(ctx, sfsClient, newActiveID, timeout); err != nil {
+			return diag.Errorf("Error promoting share replica %s before deleting openstack_sharedfilesystem_share_replica_v2 %s: %s", newActiveID, d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Attempting to delete openstack_sharedfilesystem_share_replica_v2 %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = replicas.Delete(sfsClient, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		e := CheckDeleted(d, err, "")
+		if e == nil {
+			return nil
+		}
+		detailedErr := errors.ErrorDetails{}
+		e = errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return diag.Errorf("Unable to delete openstack_sharedfilesystem_share_replica_v2 %s: %s: %s", d.Id(), err, e)
+		}
+		for k, msg := range detailedErr {
+			return diag.Errorf("Unable to delete openstack_sharedfilesystem_share_replica_v2 %s: %s (%d): %s", d.Id(), k, msg.Code, msg.Message)
+		}
+	}
+
+	// Wait for the replica to become deleted before continuing.
+	pending := []string{"", "deleting", "available"}
+	err = waitForSFV2ShareReplica(ctx, sfsClient, d.Id(), sharedFilesystemShareReplicaV2StatusRefreshFunc(sfsClient, d.Id()), "deleted", pending, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/replicas"
+)
+
+func TestAccSFSV2ShareReplica_basic(t *testing.T) {
+	var replica replicas.Replica
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareReplicaConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareReplicaExists("openstack_sharedfilesystem_share_replica_v2.replica_1", &replica),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_share_replica_v2.replica_1", "share_id",
+						"openstack_sharedfilesystem_share_v2.share_1", "id"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_1", "replica_state", "in_sync"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_1", "active", "false"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_1", "status", "available"),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareReplicaConfigPromote,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareReplicaExists("openstack_sharedfilesystem_share_replica_v2.replica_1", &replica),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_1", "replica_state", "active"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_1", "active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFSV2ShareReplica_switchActive(t *testing.T) {
+	var replica1, replica2 replicas.Replica
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareReplicaConfigSwitchActive(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareReplicaExists("openstack_sharedfilesystem_share_replica_v2.replica_1", &replica1),
+					testAccCheckSFSV2ShareReplicaExists("openstack_sharedfilesystem_share_replica_v2.replica_2", &replica2),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_1", "replica_state", "active"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_2", "active", "false"),
+				),
+			},
+			{
+				// Move the active role from replica_1 to replica_2 in a
+				// single apply.
+				Config: testAccSFSV2ShareReplicaConfigSwitchActive(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareReplicaExists("openstack_sharedfilesystem_share_replica_v2.replica_1", &replica1),
+					testAccCheckSFSV2ShareReplicaExists("openstack_sharedfilesystem_share_replica_v2.replica_2", &replica2),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_1", "active", "false"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_2", "replica_state", "active"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_replica_v2.replica_2", "active", "true"),
+				),
+			},
+			{
+				// The switch must be stable.
+				Config:   testAccSFSV2ShareReplicaConfigSwitchActive(false),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareReplicaDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_share_replica_v2" {
+			continue
+		}
+
+		_, err := replicas.Get(sfsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Manila share replica still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSV2ShareReplicaExists(n string, replica *replicas.Replica) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareReplicaMicroversion
+
+		found, err := replicas.Get(sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Share replica not found")
+		}
+
+		*replica = *found
+
+		return nil
+	}
+}
+
+const testAccSFSV2ShareReplicaConfigBasic = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name             = "nfs_share"
+  share_proto      = "NFS"
+  share_type       = "dhss_false"
+  size             = 1
+}
+
+resource "openstack_sharedfilesystem_share_replica_v2" "replica_1" {
+  share_id = openstack_sharedfilesystem_share_v2.share_1.id
+}
+`
+
+const testAccSFSV2ShareReplicaConfigPromote = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name             = "nfs_share"
+  share_proto      = "NFS"
+  share_type       = "dhss_false"
+  size             = 1
+}
+
+resource "openstack_sharedfilesystem_share_replica_v2" "replica_1" {
+  share_id = openstack_sharedfilesystem_share_v2.share_1.id
+  active   = true
+}
+`
+
+func testAccSFSV2ShareReplicaConfigSwitchActive(firstActive bool) string {
+	return fmt.Sprintf(`
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name             = "nfs_share"
+  share_proto      = "NFS"
+  share_type       = "dhss_false"
+  size             = 1
+}
+
+resource "openstack_sharedfilesystem_share_replica_v2" "replica_1" {
+  share_id = openstack_sharedfilesystem_share_v2.share_1.id
+  active   = %t
+}
+
+resource "openstack_sharedfilesystem_share_replica_v2" "replica_2" {
+  share_id = openstack_sharedfilesystem_share_v2.share_1.id
+  active   = %t
+}
+`, firstActive, !firstActive)
+}
@@ -0,0 +1,141 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/sharetypes"
+)
+
+func resourceSharedFilesystemShareTypeAccessV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareTypeAccessV2Create,
+		ReadContext:   resourceSharedFilesystemShareTypeAccessV2Read,
+		DeleteContext: resourceSharedFilesystemShareTypeAccessV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"share_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareTypeAccessV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	projectID := d.Get("project_id").(string)
+	stID := d.Get("share_type_id").(string)
+
+	accessOpts := sharetypes.AccessOpts{
+		Project: projectID,
+	}
+
+	if err := sharetypes.AddAccess(sfsClient, stID, accessOpts).ExtractErr(); err != nil {
+		return diag.Errorf("Error creating openstack_sharedfilesystem_share_type_access_v2: %s", err)
+	}
+
+	id := fmt.Sprintf("%s/%s", stID, projectID)
+	d.SetId(id)
+
+	return resourceSharedFilesystemShareTypeAccessV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareTypeAccessV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	stID, projectID, err := parseShareTypeAccessID(d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error parsing ID of openstack_sharedfilesystem_share_type_access_v2"))
+	}
+
+	allAccesses, err := sharetypes.ShowAccess(sfsClient, stID).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_type_access_v2"))
+	}
+
+	found := false
+	for _, access := range allAccesses {
+		if access.ProjectID == projectID {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("project_id", projectID)
+	d.Set("share_type_id", stID)
+
+	return nil
+}
+
+func resourceSharedFilesystemShareTypeAccessV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	stID, projectID, err := parseShareTypeAccessID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error parsing ID of openstack_sharedfilesystem_share_type_access_v2 %s: %s", d.Id(), err)
+	}
+
+	accessOpts := sharetypes.AccessOpts{
+		Project: projectID,
+	}
+
+	if err := sharetypes.RemoveAccess(sfsClient, stID, accessOpts).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error removing openstack_sharedfilesystem_share_type_access_v2"))
+	}
+
+	return nil
+}
+
+func parseShareTypeAccessID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) < 2 {
+		return "", "", fmt.Errorf("Unable to determine share type access ID %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
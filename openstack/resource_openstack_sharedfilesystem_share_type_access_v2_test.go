@@ -0,0 +1,135 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/sharetypes"
+)
+
+func TestAccSFSV2ShareTypeAccess_basic(t *testing.T) {
+	var project projects.Project
+	var projectName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	var shareType sharedFilesystemShareTypeV2
+	var shareTypeName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareTypeAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareTypeAccessConfigBasic(projectName, shareTypeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					testAccCheckSFSV2ShareTypeExists("openstack_sharedfilesystem_share_type_v2.share_type_1", &shareType),
+					testAccCheckSFSV2ShareTypeAccessExists("openstack_sharedfilesystem_share_type_access_v2.share_type_access"),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_sharedfilesystem_share_type_access_v2.share_type_access", "project_id", &project.ID),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_sharedfilesystem_share_type_access_v2.share_type_access", "share_type_id", &shareType.ID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareTypeAccessDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_share_type_access_v2" {
+			continue
+		}
+
+		stID, projectID, err := parseShareTypeAccessID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		allAccesses, err := sharetypes.ShowAccess(sfsClient, stID).Extract()
+		if err == nil {
+			for _, access := range allAccesses {
+				if access.ProjectID == projectID {
+					return fmt.Errorf("Share type access still exists")
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSV2ShareTypeAccessExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+		stID, projectID, err := parseShareTypeAccessID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		allAccesses, err := sharetypes.ShowAccess(sfsClient, stID).Extract()
+		if err != nil {
+			return fmt.Errorf("Error retrieving accesses for share type: %s", stID)
+		}
+
+		for _, access := range allAccesses {
+			if access.ProjectID == projectID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Share type access not found for share type/project: %s", rs.Primary.ID)
+	}
+}
+
+func testAccSFSV2ShareTypeAccessConfigBasic(projectName, shareTypeName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_sharedfilesystem_share_type_v2" "share_type_1" {
+  name                         = "%s"
+  is_public                    = false
+  driver_handles_share_servers = false
+}
+
+resource "openstack_sharedfilesystem_share_type_access_v2" "share_type_access" {
+  project_id    = openstack_identity_project_v3.project_1.id
+  share_type_id = openstack_sharedfilesystem_share_type_v2.share_type_1.id
+}
+`, projectName, shareTypeName)
+}
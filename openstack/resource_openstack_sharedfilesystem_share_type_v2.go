@@ -0,0 +1,212 @@
+package openstack
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/sharetypes"
+)
+
+func resourceSharedFilesystemShareTypeV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemShareTypeV2Create,
+		ReadContext:   resourceSharedFilesystemShareTypeV2Read,
+		UpdateContext: resourceSharedFilesystemShareTypeV2Update,
+		DeleteContext: resourceSharedFilesystemShareTypeV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"is_public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"driver_handles_share_servers": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"extra_specs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareTypeV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	extraSpecs := expandToMapStringString(d.Get("extra_specs").(map[string]interface{}))
+	extraSpecs["driver_handles_share_servers"] = strconv.FormatBool(d.Get("driver_handles_share_servers").(bool))
+
+	createOpts := sharedFilesystemShareTypeV2CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		IsPublic:    d.Get("is_public").(bool),
+		ExtraSpecs:  extraSpecs,
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_share_type_v2 create options: %#v", createOpts)
+	shareType, err := sharedFilesystemShareTypeV2Create(sfsClient, createOpts)
+	if err != nil {
+		return diag.Errorf("Error creating openstack_sharedfilesystem_share_type_v2: %s", err)
+	}
+
+	d.SetId(shareType.ID)
+
+	return resourceSharedFilesystemShareTypeV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareTypeV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	shareType, err := sharedFilesystemShareTypeV2Get(sfsClient, d.Id())
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_share_type_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_share_type_v2 %s: %#v", d.Id(), shareType)
+
+	d.Set("name", shareType.Name)
+	d.Set("description", shareType.Description)
+	d.Set("is_public", shareType.IsPublic)
+	d.Set("region", GetRegion(d, config))
+
+	if v, ok := shareType.ExtraSpecs["driver_handles_share_servers"].(string); ok {
+		dhss, err := strconv.ParseBool(v)
+		if err != nil {
+			return diag.Errorf("Error parsing driver_handles_share_servers of openstack_sharedfilesystem_share_type_v2 %s: %s", d.Id(), err)
+		}
+		d.Set("driver_handles_share_servers", dhss)
+	}
+
+	if err := d.Set("extra_specs", flattenSharedFilesystemShareTypeV2ExtraSpecs(shareType.ExtraSpecs)); err != nil {
+		log.Printf("[WARN] Unable to set extra_specs for openstack_sharedfilesystem_share_type_v2 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceSharedFilesystemShareTypeV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	hasChange := false
+	var updateOpts sharedFilesystemShareTypeV2UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("is_public") {
+		hasChange = true
+		isPublic := d.Get("is_public").(bool)
+		updateOpts.IsPublic = &isPublic
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_share_type_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err = sharedFilesystemShareTypeV2Update(sfsClient, d.Id(), updateOpts)
+		if err != nil {
+			return diag.Errorf("Error updating openstack_sharedfilesystem_share_type_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("extra_specs") {
+		oldES, newES := d.GetChange("extra_specs")
+		newESRaw := newES.(map[string]interface{})
+
+		// Unset the removed extra specs.
+		for oldKey := range oldES.(map[string]interface{}) {
+			if _, ok := newESRaw[oldKey]; ok {
+				continue
+			}
+
+			if err := sharetypes.UnsetExtraSpecs(sfsClient, d.Id(), oldKey).ExtractErr(); err != nil {
+				return diag.Errorf("Error unsetting extra_spec %s from openstack_sharedfilesystem_share_type_v2 %s: %s", oldKey, d.Id(), err)
+			}
+		}
+
+		// Set the new and the changed extra specs.
+		if len(newESRaw) > 0 {
+			setOpts := sharetypes.SetExtraSpecsOpts{
+				ExtraSpecs: newESRaw,
+			}
+
+			if _, err := sharetypes.SetExtraSpecs(sfsClient, d.Id(), setOpts).Extract(); err != nil {
+				return diag.Errorf("Error setting extra_specs for openstack_sharedfilesystem_share_type_v2 %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceSharedFilesystemShareTypeV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemShareTypeV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	if err := sharetypes.Delete(sfsClient, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error deleting openstack_sharedfilesystem_share_type_v2"))
+	}
+
+	return nil
+}
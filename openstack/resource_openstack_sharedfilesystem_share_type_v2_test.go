@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSFSV2ShareType_basic(t *testing.T) {
+	var shareType sharedFilesystemShareTypeV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareTypeConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareTypeExists("openstack_sharedfilesystem_share_type_v2.share_type_1", &shareType),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "name", "share_type_1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "description", "test share type"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "is_public", "true"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "driver_handles_share_servers", "false"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "extra_specs.%", "2"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "extra_specs.snapshot_support", "True"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "extra_specs.create_share_from_snapshot_support", "True"),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareTypeConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareTypeExists("openstack_sharedfilesystem_share_type_v2.share_type_1", &shareType),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "name", "share_type_1_updated"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "description", ""),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "is_public", "false"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "extra_specs.%", "1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_type_v2.share_type_1", "extra_specs.snapshot_support", "False"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2ShareTypeDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_share_type_v2" {
+			continue
+		}
+
+		_, err := sharedFilesystemShareTypeV2Get(sfsClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Manila share type still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSV2ShareTypeExists(n string, shareType *sharedFilesystemShareTypeV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+		}
+
+		sfsClient.Microversion = sharedFilesystemV2ShareTypeMicroversion
+
+		found, err := sharedFilesystemShareTypeV2Get(sfsClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Share type not found")
+		}
+
+		*shareType = *found
+
+		return nil
+	}
+}
+
+const testAccSFSV2ShareTypeConfigBasic = `
+resource "openstack_sharedfilesystem_share_type_v2" "share_type_1" {
+  name                         = "share_type_1"
+  description                  = "test share type"
+  driver_handles_share_servers = false
+
+  extra_specs = {
+    snapshot_support                   = "True"
+    create_share_from_snapshot_support = "True"
+  }
+}
+`
+
+const testAccSFSV2ShareTypeConfigUpdate = `
+resource "openstack_sharedfilesystem_share_type_v2" "share_type_1" {
+  name                         = "share_type_1_updated"
+  is_public                    = false
+  driver_handles_share_servers = false
+
+  extra_specs = {
+    snapshot_support = "False"
+  }
+}
+`
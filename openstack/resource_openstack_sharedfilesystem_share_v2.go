@@ -38,6 +38,8 @@ func resourceSharedFilesystemShareV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: resourceSharedFilesystemShareV2CustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -76,6 +78,12 @@ func resourceSharedFilesystemShareV2() *schema.Resource {
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 
+			"allow_shrink": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"share_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -89,6 +97,11 @@ func resourceSharedFilesystemShareV2() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"revert_to_snapshot_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"is_public": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -114,6 +127,12 @@ func resourceSharedFilesystemShareV2() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"share_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
 			"export_locations": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -159,6 +178,22 @@ func resourceSharedFilesystemShareV2() *schema.Resource {
 	}
 }
 
+// resourceSharedFilesystemShareV2CustomizeDiff refuses to shrink a share,
+// unless allow_shrink is set. Manila doesn't expose the used capacity of a
+// share, so a shrink can't be checked for data loss before it is requested.
+func resourceSharedFilesystemShareV2CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("size") {
+		return nil
+	}
+
+	oldSize, newSize := d.GetChange("size")
+	if newSize.(int) < oldSize.(int) && !d.Get("allow_shrink").(bool) {
+		return fmt.Errorf("Shrinking openstack_sharedfilesystem_share_v2 %s from %d GB to %d GB requires allow_shrink to be set to true", d.Id(), oldSize.(int), newSize.(int))
+	}
+
+	return nil
+}
+
 func resourceSharedFilesystemShareV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
@@ -178,16 +213,23 @@ func resourceSharedFilesystemShareV2Create(ctx context.Context, d *schema.Resour
 		}
 	}
 
-	createOpts := shares.CreateOpts{
-		Name:             d.Get("name").(string),
-		Description:      d.Get("description").(string),
-		ShareProto:       d.Get("share_proto").(string),
-		Size:             d.Get("size").(int),
-		SnapshotID:       d.Get("snapshot_id").(string),
-		IsPublic:         &isPublic,
-		Metadata:         metadata,
-		ShareNetworkID:   d.Get("share_network_id").(string),
-		AvailabilityZone: d.Get("availability_zone").(string),
+	createOpts := SharedFilesystemShareV2CreateOpts{
+		CreateOpts: shares.CreateOpts{
+			Name:             d.Get("name").(string),
+			Description:      d.Get("description").(string),
+			ShareProto:       d.Get("share_proto").(string),
+			Size:             d.Get("size").(int),
+			SnapshotID:       d.Get("snapshot_id").(string),
+			IsPublic:         &isPublic,
+			Metadata:         metadata,
+			ShareNetworkID:   d.Get("share_network_id").(string),
+			AvailabilityZone: d.Get("availability_zone").(string),
+		},
+		ShareGroupID: d.Get("share_group_id").(string),
+	}
+
+	if createOpts.ShareGroupID != "" {
+		sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
 	}
 
 	if v, ok := d.GetOkExists("share_type"); ok {
@@ -282,6 +324,16 @@ func resourceSharedFilesystemShareV2Read(ctx context.Context, d *schema.Resource
 	d.Set("replication_type", share.ReplicationType)
 	d.Set("share_server_id", share.ShareServerID)
 
+	// The share group membership is only returned by newer microversions.
+	if _, ok := d.GetOk("share_group_id"); ok {
+		sfsClient.Microversion = sharedFilesystemV2ShareGroupMicroversion
+		shareGroupID, err := sharedFilesystemShareV2GetShareGroupID(sfsClient, d.Id())
+		if err != nil {
+			return diag.Errorf("Failed to retrieve share's share_group_id %s: %s", d.Id(), err)
+		}
+		d.Set("share_group_id", shareGroupID)
+	}
+
 	return nil
 }
 
@@ -345,6 +397,42 @@ func resourceSharedFilesystemShareV2Update(ctx context.Context, d *schema.Resour
 		}
 	}
 
+	if d.HasChange("revert_to_snapshot_id") {
+		if snapshotID := d.Get("revert_to_snapshot_id").(string); snapshotID != "" {
+			// Revert is only available since microversion 2.27.
+			sfsClient.Microversion = sharedFilesystemV2ShareRevertMicroversion
+
+			revertOpts := shares.RevertOpts{SnapshotID: snapshotID}
+			log.Printf("[DEBUG] Reverting share %s with options: %#v", d.Id(), revertOpts)
+			err = resource.Retry(timeout, func() *resource.RetryError {
+				err := shares.Revert(sfsClient, d.Id(), revertOpts).ExtractErr()
+				if err != nil {
+					return checkForRetryableError(err)
+				}
+				return nil
+			})
+
+			if err != nil {
+				detailedErr := errors.ErrorDetails{}
+				e := errors.ExtractErrorInto(err, &detailedErr)
+				if e != nil {
+					return diag.Errorf("Unable to revert %s share to %s snapshot: %s: %s", d.Id(), snapshotID, err, e)
+				}
+				for k, msg := range detailedErr {
+					return diag.Errorf("Unable to revert %s share to %s snapshot: %s (%d): %s", d.Id(), snapshotID, k, msg.Code, msg.Message)
+				}
+			}
+
+			// Wait for share to become active before continuing
+			err = waitForSFV2Share(ctx, sfsClient, d.Id(), "available", []string{"reverting"}, timeout)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			sfsClient.Microversion = minManilaShareMicroversion
+		}
+	}
+
 	if d.HasChange("size") {
 		var pending []string
 		oldSize, newSize := d.GetChange("size")
@@ -388,6 +476,26 @@ func resourceSharedFilesystemShareV2Update(ctx context.Context, d *schema.Resour
 
 		// Wait for share to become active before continuing
 		err = waitForSFV2Share(ctx, sfsClient, d.Id(), "available", pending, timeout)
+
+		// Manila doesn't expose the used capacity of a share, so a shrink
+		// below it can't be detected upfront. Verify the actual size and
+		// keep the previous one in the state if the resize didn't happen.
+		share, e := shares.Get(sfsClient, d.Id()).Extract()
+		if e != nil {
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return diag.FromErr(CheckDeleted(d, e, "Error retrieving openstack_sharedfilesystem_share_v2"))
+		}
+
+		if share.Size != newSize.(int) {
+			d.Set("size", oldSize)
+			if share.Status == "shrinking_possible_data_loss_error" {
+				return diag.Errorf("Unable to shrink %s share to %d GB: the used capacity of the share exceeds the new size", d.Id(), newSize.(int))
+			}
+			return diag.Errorf("Unable to resize %s share to %d GB: the share size is still %d GB (status %s)", d.Id(), newSize.(int), share.Size, share.Status)
+		}
+
 		if err != nil {
 			return diag.FromErr(err)
 		}
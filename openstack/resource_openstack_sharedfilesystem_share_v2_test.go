@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -51,16 +52,20 @@ func TestAccSFSV2Share_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "size", "2"),
 				),
 			},
-			/*			resource.TestStep{
-						Config: testAccSFSV2ShareConfig_shrink,
-						Check: resource.ComposeTestCheckFunc(
-							testAccCheckSFSV2ShareExists("openstack_sharedfilesystem_share_v2.share_1", &share),
-							resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "name", "nfs_share_shrunk"),
-							resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "is_public", "false"),
-							resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "share_proto", "NFS"),
-							resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "size", "1"),
-						),
-					},*/
+			{
+				Config:      testAccSFSV2ShareConfigShrinkNotAllowed,
+				ExpectError: regexp.MustCompile("requires allow_shrink to be set to true"),
+			},
+			{
+				Config: testAccSFSV2ShareConfigShrink,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareExists("openstack_sharedfilesystem_share_v2.share_1", &share),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "name", "nfs_share_shrunk"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "is_public", "false"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "share_proto", "NFS"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "size", "1"),
+				),
+			},
 		},
 	})
 }
@@ -118,6 +123,37 @@ func TestAccSFSV2Share_update(t *testing.T) {
 	})
 }
 
+func TestAccSFSV2Share_revert(t *testing.T) {
+	var share shares.Share
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2ShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2SnapshotConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareExists("openstack_sharedfilesystem_share_v2.share_1", &share),
+				),
+			},
+			{
+				Config: testAccSFSV2ShareConfigRevert,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2ShareExists("openstack_sharedfilesystem_share_v2.share_1", &share),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_share_v2.share_1", "revert_to_snapshot_id",
+						"openstack_sharedfilesystem_snapshot_v2.snapshot_1", "id"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_share_v2.share_1", "size", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSFSV2Share_admin(t *testing.T) {
 	var share shares.Share
 
@@ -272,14 +308,24 @@ resource "openstack_sharedfilesystem_share_v2" "share_1" {
 }
 `
 
-//const testAccSFSV2ShareConfigShrink = `
-//resource "openstack_sharedfilesystem_share_v2" "share_1" {
-//  name             = "nfs_share_shrunk"
-//  share_proto      = "NFS"
-//  share_type       = "dhss_false"
-//  size             = 1
-//}
-//`
+const testAccSFSV2ShareConfigShrinkNotAllowed = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name             = "nfs_share_extended"
+  share_proto      = "NFS"
+  share_type       = "dhss_false"
+  size             = 1
+}
+`
+
+const testAccSFSV2ShareConfigShrink = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name             = "nfs_share_shrunk"
+  share_proto      = "NFS"
+  share_type       = "dhss_false"
+  size             = 1
+  allow_shrink     = true
+}
+`
 
 const testAccSFSV2ShareConfigMetadataUpdate = `
 resource "openstack_sharedfilesystem_share_v2" "share_1" {
@@ -334,6 +380,28 @@ resource "openstack_sharedfilesystem_share_v2" "share_1" {
 }
 `
 
+// The snapshot is looked up by name, because referencing the snapshot resource
+// from the share would create a dependency cycle.
+const testAccSFSV2ShareConfigRevert = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name                  = "nfs_share"
+  share_proto           = "NFS"
+  share_type            = "dhss_false"
+  size                  = 1
+  revert_to_snapshot_id = data.openstack_sharedfilesystem_snapshot_v2.snapshot_1.id
+}
+
+resource "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  share_id    = openstack_sharedfilesystem_share_v2.share_1.id
+  name        = "snapshot_1"
+  description = "test snapshot description"
+}
+
+data "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  name = "snapshot_1"
+}
+`
+
 const testAccSFSV2ShareAdminConfigBasic = `
 resource "openstack_sharedfilesystem_share_v2" "share_1" {
   name             = "nfs_share_admin"
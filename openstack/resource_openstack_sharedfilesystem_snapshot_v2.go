@@ -0,0 +1,283 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/errors"
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/snapshots"
+)
+
+func resourceSharedFilesystemSnapshotV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSharedFilesystemSnapshotV2Create,
+		ReadContext:   resourceSharedFilesystemSnapshotV2Read,
+		UpdateContext: resourceSharedFilesystemSnapshotV2Update,
+		DeleteContext: resourceSharedFilesystemSnapshotV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"share_proto": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"share_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemSnapshotV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	createOpts := snapshots.CreateOpts{
+		ShareID:     d.Get("share_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_sharedfilesystem_snapshot_v2 create options: %#v", createOpts)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	var snapshot *snapshots.Snapshot
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		snapshot, err = snapshots.Create(sfsClient, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		detailedErr := errors.ErrorDetails{}
+		e := errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return diag.Errorf("Error creating openstack_sharedfilesystem_snapshot_v2: %s: %s", err, e)
+		}
+		for k, msg := range detailedErr {
+			return diag.Errorf("Error creating openstack_sharedfilesystem_snapshot_v2: %s (%d): %s", k, msg.Code, msg.Message)
+		}
+	}
+
+	d.SetId(snapshot.ID)
+
+	// Wait for the snapshot to become available before continuing.
+	err = waitForSFV2Snapshot(ctx, sfsClient, snapshot.ID, "available", []string{"creating"}, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSharedFilesystemSnapshotV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemSnapshotV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	snapshot, err := snapshots.Get(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_snapshot_v2"))
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_snapshot_v2 %s: %#v", d.Id(), snapshot)
+
+	d.Set("share_id", snapshot.ShareID)
+	d.Set("name", snapshot.Name)
+	d.Set("description", snapshot.Description)
+	d.Set("status", snapshot.Status)
+	d.Set("size", snapshot.Size)
+	d.Set("share_proto", snapshot.ShareProto)
+	d.Set("share_size", snapshot.ShareSize)
+	d.Set("project_id", snapshot.ProjectID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemSnapshotV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	var updateOpts snapshots.UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.DisplayName = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.DisplayDescription = &description
+	}
+
+	if updateOpts != (snapshots.UpdateOpts{}) {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_snapshot_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err = snapshots.Update(sfsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return diag.Errorf("Error updating openstack_sharedfilesystem_snapshot_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSharedFilesystemSnapshotV2Read(ctx, d, meta)
+}
+
+func resourceSharedFilesystemSnapshotV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return diag.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = minManilaShareMicroversion
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	log.Printf("[DEBUG] Attempting to delete openstack_sharedfilesystem_snapshot_v2 %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = snapshots.Delete(sfsClient, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		e := CheckDeleted(d, err, "")
+		if e == nil {
+			return nil
+		}
+		detailedErr := errors.ErrorDetails{}
+		e = errors.ExtractErrorInto(err, &detailedErr)
+		if e != nil {
+			return diag.Errorf("Unable to delete openstack_sharedfilesystem_snapshot_v2 %s: %s: %s", d.Id(), err, e)
+		}
+		for k, msg := range detailedErr {
+			return diag.Errorf("Unable to delete openstack_sharedfilesystem_snapshot_v2 %s: %s (%d): %s", d.Id(), k, msg.Code, msg.Message)
+		}
+	}
+
+	// Wait for the snapshot to become deleted before continuing.
+	pending := []string{"", "deleting", "available"}
+	err = waitForSFV2Snapshot(ctx, sfsClient, d.Id(), "deleted", pending, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// Full list of the snapshot statuses: https://docs.openstack.org/api-ref/shared-file-system/#share-snapshots
+func waitForSFV2Snapshot(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string, target string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for snapshot %s to become %s.", id, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{target},
+		Pending:    pending,
+		Refresh:    resourceSFV2SnapshotRefreshFunc(sfsClient, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			switch target {
+			case "deleted":
+				return nil
+			default:
+				return fmt.Errorf("Error: snapshot %s not found: %s", id, err)
+			}
+		}
+		errorMessage := fmt.Sprintf("Error waiting for snapshot %s to become %s", id, target)
+		msg := resourceSFSV2ShareManilaMessage(sfsClient, id)
+		if msg == nil {
+			return fmt.Errorf("%s: %s", errorMessage, err)
+		}
+		return fmt.Errorf("%s: %s: the latest manila message (%s): %s", errorMessage, err, msg.CreatedAt, msg.UserMessage)
+	}
+
+	return nil
+}
+
+func resourceSFV2SnapshotRefreshFunc(sfsClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := snapshots.Get(sfsClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+		return snapshot, snapshot.Status, nil
+	}
+}
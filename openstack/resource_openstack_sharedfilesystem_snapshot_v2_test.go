@@ -0,0 +1,130 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/snapshots"
+)
+
+func TestAccSFSV2Snapshot_basic(t *testing.T) {
+	var snapshot snapshots.Snapshot
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckSFSV2SnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2SnapshotConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2SnapshotExists("openstack_sharedfilesystem_snapshot_v2.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "name", "snapshot_1"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "description", "test snapshot description"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "status", "available"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "share_proto", "NFS"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "share_size", "1"),
+					resource.TestCheckResourceAttrPair("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "share_id",
+						"openstack_sharedfilesystem_share_v2.share_1", "id"),
+				),
+			},
+			{
+				Config: testAccSFSV2SnapshotConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSV2SnapshotExists("openstack_sharedfilesystem_snapshot_v2.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "name", "snapshot_1_updated"),
+					resource.TestCheckResourceAttr("openstack_sharedfilesystem_snapshot_v2.snapshot_1", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSV2SnapshotDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_snapshot_v2" {
+			continue
+		}
+
+		_, err := snapshots.Get(sfsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Manila snapshot still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSV2SnapshotExists(n string, snapshot *snapshots.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.SharedfilesystemV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+		}
+
+		found, err := snapshots.Get(sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Snapshot not found")
+		}
+
+		*snapshot = *found
+
+		return nil
+	}
+}
+
+const testAccSFSV2SnapshotConfigBasic = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name             = "nfs_share"
+  share_proto      = "NFS"
+  share_type       = "dhss_false"
+  size             = 1
+}
+
+resource "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  share_id    = openstack_sharedfilesystem_share_v2.share_1.id
+  name        = "snapshot_1"
+  description = "test snapshot description"
+}
+`
+
+const testAccSFSV2SnapshotConfigUpdate = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name             = "nfs_share"
+  share_proto      = "NFS"
+  share_type       = "dhss_false"
+  size             = 1
+}
+
+resource "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  share_id    = openstack_sharedfilesystem_share_v2.share_1.id
+  name        = "snapshot_1_updated"
+}
+`
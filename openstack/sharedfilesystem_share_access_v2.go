@@ -11,6 +11,54 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/shares"
 )
 
+// SharedFilesystemShareAccessV2GrantOpts is a custom GrantAccessOpts struct
+// to include the access rule metadata and the resource locks.
+type SharedFilesystemShareAccessV2GrantOpts struct {
+	shares.GrantAccessOpts
+	Metadata       map[string]string `json:"metadata,omitempty"`
+	LockVisibility bool              `json:"lock_visibility,omitempty"`
+	LockDeletion   bool              `json:"lock_deletion,omitempty"`
+	LockReason     string            `json:"lock_reason,omitempty"`
+}
+
+// ToGrantAccessMap casts a GrantAccessOpts struct to a map.
+// It overrides shares.ToGrantAccessMap to add the Metadata and lock fields.
+func (opts SharedFilesystemShareAccessV2GrantOpts) ToGrantAccessMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "allow_access")
+}
+
+// SharedFilesystemShareAccessV2RevokeOpts is a custom RevokeAccessOpts struct
+// to include the Unrestrict field, which removes the deletion lock.
+type SharedFilesystemShareAccessV2RevokeOpts struct {
+	shares.RevokeAccessOpts
+	Unrestrict bool `json:"unrestrict,omitempty"`
+}
+
+// ToRevokeAccessMap casts a RevokeAccessOpts struct to a map.
+// It overrides shares.ToRevokeAccessMap to add the Unrestrict field.
+func (opts SharedFilesystemShareAccessV2RevokeOpts) ToRevokeAccessMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "deny_access")
+}
+
+func sharedFilesystemShareAccessV2SetMetadata(client *gophercloud.ServiceClient, accessID string, metadata map[string]string) error {
+	b := map[string]interface{}{
+		"metadata": metadata,
+	}
+	_, err := client.Put(client.ServiceURL("share-access-rules", accessID, "metadata"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func sharedFilesystemShareAccessV2DeleteMetadatum(client *gophercloud.ServiceClient, accessID string, key string) error {
+	_, err := client.Delete(client.ServiceURL("share-access-rules", accessID, "metadata", key), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+
+	return err
+}
+
 func sharedFilesystemShareAccessV2StateRefreshFunc(client *gophercloud.ServiceClient, shareID string, accessID string) resource.StateRefreshFunc {
 	// Set the client to the minimum supported microversion.
 	client.Microversion = sharedFilesystemV2MinMicroversion
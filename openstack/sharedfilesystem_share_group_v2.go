@@ -0,0 +1,319 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/shares"
+)
+
+// SharedFilesystemShareV2CreateOpts is a custom Share struct to include the
+// ShareGroupID field.
+type SharedFilesystemShareV2CreateOpts struct {
+	shares.CreateOpts
+	ShareGroupID string `json:"share_group_id,omitempty"`
+}
+
+// ToShareCreateMap casts a CreateOpts struct to a map.
+// It overrides shares.ToShareCreateMap to add the ShareGroupID field.
+func (opts SharedFilesystemShareV2CreateOpts) ToShareCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "share")
+}
+
+// sharedFilesystemShareV2GetShareGroupID returns the ID of the share group,
+// which the share is a member of.
+func sharedFilesystemShareV2GetShareGroupID(client *gophercloud.ServiceClient, id string) (string, error) {
+	var res struct {
+		Share struct {
+			ShareGroupID string `json:"share_group_id"`
+		} `json:"share"`
+	}
+	if err := shares.Get(client, id).ExtractInto(&res); err != nil {
+		return "", err
+	}
+
+	return res.Share.ShareGroupID, nil
+}
+
+// sharedFilesystemShareGroupTypeV2CreateOpts represents the attributes used
+// when creating a new share group type.
+type sharedFilesystemShareGroupTypeV2CreateOpts struct {
+	Name       string            `json:"name,omitempty"`
+	ShareTypes []string          `json:"share_types" required:"true"`
+	IsPublic   bool              `json:"is_public"`
+	GroupSpecs map[string]string `json:"group_specs,omitempty"`
+}
+
+// sharedFilesystemShareGroupTypeV2 represents a share group type.
+type sharedFilesystemShareGroupTypeV2 struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	ShareTypes []string          `json:"share_types"`
+	IsPublic   bool              `json:"is_public"`
+	GroupSpecs map[string]string `json:"group_specs"`
+}
+
+func sharedFilesystemShareGroupTypeV2Create(client *gophercloud.ServiceClient, opts sharedFilesystemShareGroupTypeV2CreateOpts) (*sharedFilesystemShareGroupTypeV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_group_type")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		ShareGroupType *sharedFilesystemShareGroupTypeV2 `json:"share_group_type"`
+	}
+	_, err = client.Post(client.ServiceURL("share-group-types"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return res.ShareGroupType, err
+}
+
+func sharedFilesystemShareGroupTypeV2Get(client *gophercloud.ServiceClient, id string) (*sharedFilesystemShareGroupTypeV2, error) {
+	var res struct {
+		ShareGroupType *sharedFilesystemShareGroupTypeV2 `json:"share_group_type"`
+	}
+	_, err := client.Get(client.ServiceURL("share-group-types", id), &res, nil)
+
+	return res.ShareGroupType, err
+}
+
+func sharedFilesystemShareGroupTypeV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("share-group-types", id), &gophercloud.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+
+	return err
+}
+
+func sharedFilesystemShareGroupTypeV2SetGroupSpecs(client *gophercloud.ServiceClient, id string, groupSpecs map[string]string) error {
+	b := map[string]interface{}{
+		"group_specs": groupSpecs,
+	}
+	_, err := client.Post(client.ServiceURL("share-group-types", id, "group-specs"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return err
+}
+
+func sharedFilesystemShareGroupTypeV2UnsetGroupSpec(client *gophercloud.ServiceClient, id string, key string) error {
+	_, err := client.Delete(client.ServiceURL("share-group-types", id, "group-specs", key), &gophercloud.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+
+	return err
+}
+
+// sharedFilesystemShareGroupV2CreateOpts represents the attributes used when
+// creating a new share group.
+type sharedFilesystemShareGroupV2CreateOpts struct {
+	Name                       string   `json:"name,omitempty"`
+	Description                string   `json:"description,omitempty"`
+	ShareTypes                 []string `json:"share_types,omitempty"`
+	ShareGroupTypeID           string   `json:"share_group_type_id,omitempty"`
+	ShareNetworkID             string   `json:"share_network_id,omitempty"`
+	SourceShareGroupSnapshotID string   `json:"source_share_group_snapshot_id,omitempty"`
+	AvailabilityZone           string   `json:"availability_zone,omitempty"`
+}
+
+// sharedFilesystemShareGroupV2UpdateOpts represents the attributes used when
+// updating an existing share group.
+type sharedFilesystemShareGroupV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// sharedFilesystemShareGroupV2 represents a share group.
+type sharedFilesystemShareGroupV2 struct {
+	ID                         string   `json:"id"`
+	Name                       string   `json:"name"`
+	Description                string   `json:"description"`
+	Status                     string   `json:"status"`
+	ShareTypes                 []string `json:"share_types"`
+	ShareGroupTypeID           string   `json:"share_group_type_id"`
+	ShareNetworkID             string   `json:"share_network_id"`
+	ShareServerID              string   `json:"share_server_id"`
+	SourceShareGroupSnapshotID string   `json:"source_share_group_snapshot_id"`
+	AvailabilityZone           string   `json:"availability_zone"`
+	Host                       string   `json:"host"`
+	ProjectID                  string   `json:"project_id"`
+	ConsistentSnapshotSupport  string   `json:"consistent_snapshot_support"`
+}
+
+func sharedFilesystemShareGroupV2Create(client *gophercloud.ServiceClient, opts sharedFilesystemShareGroupV2CreateOpts) (*sharedFilesystemShareGroupV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_group")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		ShareGroup *sharedFilesystemShareGroupV2 `json:"share_group"`
+	}
+	_, err = client.Post(client.ServiceURL("share-groups"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return res.ShareGroup, err
+}
+
+func sharedFilesystemShareGroupV2Get(client *gophercloud.ServiceClient, id string) (*sharedFilesystemShareGroupV2, error) {
+	var res struct {
+		ShareGroup *sharedFilesystemShareGroupV2 `json:"share_group"`
+	}
+	_, err := client.Get(client.ServiceURL("share-groups", id), &res, nil)
+
+	return res.ShareGroup, err
+}
+
+func sharedFilesystemShareGroupV2Update(client *gophercloud.ServiceClient, id string, opts sharedFilesystemShareGroupV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "share_group")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("share-groups", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func sharedFilesystemShareGroupV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("share-groups", id), &gophercloud.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+
+	return err
+}
+
+// sharedFilesystemShareGroupSnapshotV2CreateOpts represents the attributes
+// used when creating a new share group snapshot.
+type sharedFilesystemShareGroupSnapshotV2CreateOpts struct {
+	ShareGroupID string `json:"share_group_id" required:"true"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+// sharedFilesystemShareGroupSnapshotV2UpdateOpts represents the attributes
+// used when updating an existing share group snapshot.
+type sharedFilesystemShareGroupSnapshotV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// sharedFilesystemShareGroupSnapshotV2 represents a share group snapshot.
+type sharedFilesystemShareGroupSnapshotV2 struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Status       string `json:"status"`
+	ShareGroupID string `json:"share_group_id"`
+	ProjectID    string `json:"project_id"`
+}
+
+func sharedFilesystemShareGroupSnapshotV2Create(client *gophercloud.ServiceClient, opts sharedFilesystemShareGroupSnapshotV2CreateOpts) (*sharedFilesystemShareGroupSnapshotV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_group_snapshot")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		ShareGroupSnapshot *sharedFilesystemShareGroupSnapshotV2 `json:"share_group_snapshot"`
+	}
+	_, err = client.Post(client.ServiceURL("share-group-snapshots"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return res.ShareGroupSnapshot, err
+}
+
+func sharedFilesystemShareGroupSnapshotV2Get(client *gophercloud.ServiceClient, id string) (*sharedFilesystemShareGroupSnapshotV2, error) {
+	var res struct {
+		ShareGroupSnapshot *sharedFilesystemShareGroupSnapshotV2 `json:"share_group_snapshot"`
+	}
+	_, err := client.Get(client.ServiceURL("share-group-snapshots", id), &res, nil)
+
+	return res.ShareGroupSnapshot, err
+}
+
+func sharedFilesystemShareGroupSnapshotV2Update(client *gophercloud.ServiceClient, id string, opts sharedFilesystemShareGroupSnapshotV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "share_group_snapshot")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("share-group-snapshots", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func sharedFilesystemShareGroupSnapshotV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("share-group-snapshots", id), &gophercloud.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+
+	return err
+}
+
+// waitForSFV2ShareGroup waits for a share group or a share group snapshot,
+// which is refreshed by the refresh function, to become target.
+func waitForSFV2ShareGroup(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string, refresh resource.StateRefreshFunc, target string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for share group resource %s to become %s.", id, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{target},
+		Pending:    pending,
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			switch target {
+			case "deleted":
+				return nil
+			default:
+				return fmt.Errorf("Error: share group resource %s not found: %s", id, err)
+			}
+		}
+		errorMessage := fmt.Sprintf("Error waiting for share group resource %s to become %s", id, target)
+		msg := resourceSFSV2ShareManilaMessage(sfsClient, id)
+		if msg == nil {
+			return fmt.Errorf("%s: %s", errorMessage, err)
+		}
+		return fmt.Errorf("%s: %s: the latest manila message (%s): %s", errorMessage, err, msg.CreatedAt, msg.UserMessage)
+	}
+
+	return nil
+}
+
+func sharedFilesystemShareGroupV2RefreshFunc(sfsClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		shareGroup, err := sharedFilesystemShareGroupV2Get(sfsClient, id)
+		if err != nil {
+			return nil, "", err
+		}
+		return shareGroup, shareGroup.Status, nil
+	}
+}
+
+func sharedFilesystemShareGroupSnapshotV2RefreshFunc(sfsClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := sharedFilesystemShareGroupSnapshotV2Get(sfsClient, id)
+		if err != nil {
+			return nil, "", err
+		}
+		return snapshot, snapshot.Status, nil
+	}
+}
@@ -0,0 +1,190 @@
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/replicas"
+)
+
+// waitForSFV2ShareReplica waits for a share replica attribute, which is
+// returned by the refresh function, to become target.
+func waitForSFV2ShareReplica(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string, refresh resource.StateRefreshFunc, target string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for share replica %s to become %s.", id, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{target},
+		Pending:    pending,
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			switch target {
+			case "deleted":
+				return nil
+			default:
+				return fmt.Errorf("Error: share replica %s not found: %s", id, err)
+			}
+		}
+		errorMessage := fmt.Sprintf("Error waiting for share replica %s to become %s", id, target)
+		msg := resourceSFSV2ShareManilaMessage(sfsClient, id)
+		if msg == nil {
+			return fmt.Errorf("%s: %s", errorMessage, err)
+		}
+		return fmt.Errorf("%s: %s: the latest manila message (%s): %s", errorMessage, err, msg.CreatedAt, msg.UserMessage)
+	}
+
+	return nil
+}
+
+// sharedFilesystemShareReplicaV2StatusRefreshFunc refreshes the status of a
+// share replica, e.g. "creating" or "available".
+func sharedFilesystemShareReplicaV2StatusRefreshFunc(sfsClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		replica, err := replicas.Get(sfsClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+		return replica, replica.Status, nil
+	}
+}
+
+// sharedFilesystemShareReplicaV2StateRefreshFunc refreshes the replication
+// state of a share replica, e.g. "out_of_sync", "in_sync" or "active".
+func sharedFilesystemShareReplicaV2StateRefreshFunc(sfsClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		replica, err := replicas.Get(sfsClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+		return replica, replica.State, nil
+	}
+}
+
+// sharedFilesystemShareReplicaV2Promote promotes a share replica to be the
+// active replica of its share and waits for the promotion to finish.
+func sharedFilesystemShareReplicaV2Promote(ctx context.Context, sfsClient *gophercloud.ServiceClient, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Promoting share replica %s", id)
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		err := replicas.Promote(sfsClient, id, replicas.PromoteOpts{}).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Unable to promote share replica %s: %s", id, err)
+	}
+
+	err = waitForSFV2ShareReplica(ctx, sfsClient, id, sharedFilesystemShareReplicaV2StatusRefreshFunc(sfsClient, id), "available", []string{"replication_change"}, timeout)
+	if err != nil {
+		return err
+	}
+
+	return waitForSFV2ShareReplica(ctx, sfsClient, id, sharedFilesystemShareReplicaV2StateRefreshFunc(sfsClient, id), "active", []string{"in_sync", "out_of_sync"}, timeout)
+}
+
+// sharedFilesystemShareReplicaV2PromotionGracePeriod is the time given to
+// another replica of the share to start its promotion, e.g. when its
+// openstack_sharedfilesystem_share_replica_v2 resource is updated in parallel.
+const sharedFilesystemShareReplicaV2PromotionGracePeriod = 1 * time.Minute
+
+// sharedFilesystemShareReplicaV2WaitForDemotion waits for a share replica to
+// lose the active role. Manila can't demote a replica directly, it happens
+// when another replica of the share is promoted, e.g. by another
+// openstack_sharedfilesystem_share_replica_v2 resource in the same apply.
+// It fails immediately when the share has no other in_sync replica and after
+// a grace period when no other replica is being promoted.
+func sharedFilesystemShareReplicaV2WaitForDemotion(ctx context.Context, sfsClient *gophercloud.ServiceClient, id, shareID string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for another replica of share %s to take over the active role of share replica %s", shareID, id)
+
+	start := time.Now()
+	stateConf := &resource.StateChangeConf{
+		Target:  []string{"in_sync", "out_of_sync"},
+		Pending: []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			replica, err := replicas.Get(sfsClient, id).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			if replica.State != "active" {
+				return replica, replica.State, nil
+			}
+
+			allPages, err := replicas.ListDetail(sfsClient, replicas.ListOpts{ShareID: shareID}).AllPages()
+			if err != nil {
+				return nil, "", fmt.Errorf("Unable to list replicas of share %s: %s", shareID, err)
+			}
+			allReplicas, err := replicas.ExtractReplicas(allPages)
+			if err != nil {
+				return nil, "", fmt.Errorf("Unable to extract replicas of share %s: %s", shareID, err)
+			}
+
+			var promoting, promotable bool
+			for _, r := range allReplicas {
+				if r.ID == id {
+					continue
+				}
+				if r.Status == "replication_change" {
+					promoting = true
+				}
+				if r.State == "in_sync" {
+					promotable = true
+				}
+			}
+
+			switch {
+			case promoting:
+			case !promotable:
+				return nil, "", fmt.Errorf("share %s has no other in_sync replica, which can be promoted", shareID)
+			case time.Since(start) > sharedFilesystemShareReplicaV2PromotionGracePeriod:
+				return nil, "", fmt.Errorf("no other replica of share %s is being promoted, set active to true on it instead", shareID)
+			}
+
+			return replica, replica.State, nil
+		},
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error waiting for share replica %s to be demoted: %s", id, err)
+	}
+
+	return nil
+}
+
+// sharedFilesystemShareReplicaV2FindInSync returns the ID of an in_sync
+// replica of the share, other than the excluded one, which can take over the
+// active role. An empty string is returned, when there is no such replica.
+func sharedFilesystemShareReplicaV2FindInSync(sfsClient *gophercloud.ServiceClient, shareID, excludeID string) (string, error) {
+	allPages, err := replicas.ListDetail(sfsClient, replicas.ListOpts{ShareID: shareID}).AllPages()
+	if err != nil {
+		return "", fmt.Errorf("Unable to list replicas of share %s: %s", shareID, err)
+	}
+
+	allReplicas, err := replicas.ExtractReplicas(allPages)
+	if err != nil {
+		return "", fmt.Errorf("Unable to extract replicas of share %s: %s", shareID, err)
+	}
+
+	for _, r := range allReplicas {
+		if r.ID != excludeID && r.State == "in_sync" {
+			return r.ID, nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,93 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// sharedFilesystemShareTypeV2CreateOpts represents the attributes used when
+// creating a new share type. Unlike sharetypes.CreateOpts it supports the
+// description and arbitrary extra specs.
+type sharedFilesystemShareTypeV2CreateOpts struct {
+	Name        string            `json:"name" required:"true"`
+	Description string            `json:"description,omitempty"`
+	IsPublic    bool              `json:"share_type_access:is_public"`
+	ExtraSpecs  map[string]string `json:"extra_specs" required:"true"`
+}
+
+// sharedFilesystemShareTypeV2UpdateOpts represents the attributes used when
+// updating an existing share type.
+type sharedFilesystemShareTypeV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IsPublic    *bool   `json:"share_type_access:is_public,omitempty"`
+}
+
+// sharedFilesystemShareTypeV2 represents a share type. Unlike
+// sharetypes.ShareType it includes the description and the visibility key,
+// which is returned by newer microversions.
+type sharedFilesystemShareTypeV2 struct {
+	ID                 string                 `json:"id"`
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description"`
+	IsPublic           bool                   `json:"share_type_access:is_public"`
+	RequiredExtraSpecs map[string]interface{} `json:"required_extra_specs"`
+	ExtraSpecs         map[string]interface{} `json:"extra_specs"`
+}
+
+func sharedFilesystemShareTypeV2Create(client *gophercloud.ServiceClient, opts sharedFilesystemShareTypeV2CreateOpts) (*sharedFilesystemShareTypeV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_type")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		ShareType *sharedFilesystemShareTypeV2 `json:"share_type"`
+	}
+	_, err = client.Post(client.ServiceURL("types"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return res.ShareType, err
+}
+
+func sharedFilesystemShareTypeV2Get(client *gophercloud.ServiceClient, id string) (*sharedFilesystemShareTypeV2, error) {
+	var res struct {
+		ShareType *sharedFilesystemShareTypeV2 `json:"share_type"`
+	}
+	_, err := client.Get(client.ServiceURL("types", id), &res, nil)
+
+	return res.ShareType, err
+}
+
+func sharedFilesystemShareTypeV2Update(client *gophercloud.ServiceClient, id string, opts sharedFilesystemShareTypeV2UpdateOpts) (*sharedFilesystemShareTypeV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "share_type")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		ShareType *sharedFilesystemShareTypeV2 `json:"share_type"`
+	}
+	_, err = client.Put(client.ServiceURL("types", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return res.ShareType, err
+}
+
+// flattenSharedFilesystemShareTypeV2ExtraSpecs converts the extra specs of a
+// share type to a map of strings. The driver_handles_share_servers extra spec
+// is omitted, because it has a dedicated argument.
+func flattenSharedFilesystemShareTypeV2ExtraSpecs(extraSpecs map[string]interface{}) map[string]string {
+	m := make(map[string]string, len(extraSpecs))
+	for k, v := range extraSpecs {
+		if k == "driver_handles_share_servers" {
+			continue
+		}
+		if s, ok := v.(string); ok {
+			m[k] = s
+		}
+	}
+
+	return m
+}